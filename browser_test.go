@@ -4,7 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"html"
+	"math"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -23,7 +28,6 @@ const (
 	goCoverRaw  = GoCoverageRaw
 	screenshot1 = ScreenshotInitial
 	screenshot2 = ScreenshotAfterClick
-	indexHTML   = CoverageIndexHTML
 )
 
 // TDD Phase 1: Core Browser API Tests
@@ -397,6 +401,264 @@ func (s *BrowserTestSuite) TestScreenshotCapabilities() {
 	})
 }
 
+func (s *BrowserTestSuite) TestScreenshotAndSave() {
+	browser, err := NewBrowser(BrowserOptions{Headless: true})
+	s.Require().NoError(err)
+	defer browser.Close()
+
+	page, err := browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body><h1>Save me</h1></body></html>`)
+	s.Require().NoError(err)
+
+	dir := s.T().TempDir()
+	minSize := func(size int) func([]byte) bool {
+		return func(data []byte) bool { return len(data) > size }
+	}
+
+	s.Run("saved when the condition passes", func() {
+		path := filepath.Join(dir, "shots", "saved.png")
+		data, err := page.ScreenshotAndSave(ScreenshotOptions{}, path, minSize(100))
+		s.Require().NoError(err)
+
+		written, err := os.ReadFile(path)
+		s.Require().NoError(err)
+		s.Equal(data, written)
+	})
+
+	s.Run("skipped when the condition fails", func() {
+		path := filepath.Join(dir, "skipped.png")
+		data, err := page.ScreenshotAndSave(ScreenshotOptions{}, path, minSize(math.MaxInt))
+		s.Require().NoError(err)
+		s.NotEmpty(data, "The screenshot is returned even when not saved")
+		s.NoFileExists(path)
+	})
+
+	s.Run("nil condition always saves", func() {
+		path := filepath.Join(dir, "always.jpg")
+		_, err := page.ScreenshotAndSave(ScreenshotOptions{}, path, nil)
+		s.Require().NoError(err)
+		s.FileExists(path)
+	})
+}
+
+func (s *BrowserTestSuite) TestUserAgent() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/user-agent", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, `<html><body><div id="ua">%s</div></body></html>`, html.EscapeString(r.UserAgent()))
+	})
+
+	const userAgent = "rodwer-test-agent/1.0"
+	browser, err := NewBrowser(BrowserOptions{Headless: true, UserAgent: userAgent})
+	s.Require().NoError(err)
+	defer browser.Close()
+
+	readUserAgent := func(page *Page) string {
+		err := page.Navigate(testServer.URL + "/user-agent")
+		s.Require().NoError(err)
+
+		el, err := page.Element("#ua")
+		s.Require().NoError(err)
+		text, err := el.Text()
+		s.Require().NoError(err)
+		return text
+	}
+
+	s.Run("new page sends the custom user agent", func() {
+		page, err := browser.NewPage()
+		s.Require().NoError(err)
+		defer page.Close()
+
+		s.Equal(userAgent, readUserAgent(page))
+	})
+
+	s.Run("adopted pages send the custom user agent", func() {
+		// Open a tab behind the wrapper's back, then adopt it through Pages
+		_, err := browser.browser.Page(proto.TargetCreateTarget{})
+		s.Require().NoError(err)
+
+		pages, err := browser.Pages()
+		s.Require().NoError(err)
+		s.Require().NotEmpty(pages)
+
+		for _, page := range pages {
+			s.Equal(userAgent, readUserAgent(page))
+		}
+	})
+}
+
+func (s *BrowserTestSuite) TestStealthLite() {
+	readWebdriver := func(options BrowserOptions) interface{} {
+		browser, err := NewBrowser(options)
+		s.Require().NoError(err)
+		defer browser.Close()
+
+		page, err := browser.NewPage()
+		s.Require().NoError(err)
+
+		err = page.Navigate("data:text/html,<html><body>stealth</body></html>")
+		s.Require().NoError(err)
+
+		webdriver, err := page.Evaluate(`() => navigator.webdriver`)
+		s.Require().NoError(err)
+		return webdriver
+	}
+
+	s.Equal(true, readWebdriver(BrowserOptions{Headless: true}), "Automation is visible by default")
+	s.Equal(false, readWebdriver(BrowserOptions{Headless: true, StealthLite: true}))
+
+	s.Run("adopted pages are covered", func() {
+		browser, err := NewBrowser(BrowserOptions{Headless: true, StealthLite: true})
+		s.Require().NoError(err)
+		defer browser.Close()
+
+		// Open a tab behind the wrapper's back, then adopt it through Pages
+		rodPage, err := browser.browser.Page(proto.TargetCreateTarget{URL: "data:text/html,<html><body>adopted</body></html>"})
+		s.Require().NoError(err)
+
+		pages, err := browser.Pages()
+		s.Require().NoError(err)
+
+		var adopted *Page
+		for _, page := range pages {
+			if page.page.TargetID == rodPage.TargetID {
+				adopted = page
+			}
+		}
+		s.Require().NotNil(adopted)
+
+		webdriver, err := adopted.Evaluate(`() => navigator.webdriver`)
+		s.Require().NoError(err)
+		s.Equal(false, webdriver, "The current document is covered")
+
+		s.Require().NoError(adopted.Navigate("data:text/html,<html><body>next</body></html>"))
+		webdriver, err = adopted.Evaluate(`() => navigator.webdriver`)
+		s.Require().NoError(err)
+		s.Equal(false, webdriver, "Later documents are covered")
+
+		installed := len(browser.stealthTargets)
+		_, err = browser.Pages()
+		s.Require().NoError(err)
+		s.Len(browser.stealthTargets, installed, "Listing pages again doesn't reinstall the script")
+	})
+}
+
+func (s *BrowserTestSuite) TestProxyRules() {
+	// A minimal forward proxy answering for any host it is asked about
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, `<html><body><div id="via">proxy:%s</div></body></html>`, html.EscapeString(r.Host))
+	}))
+	defer proxy.Close()
+
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/via", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body><div id="via">direct</div></body></html>`))
+	})
+
+	browser, err := NewBrowser(BrowserOptions{
+		Headless: true,
+		Proxy: &ProxyConfig{
+			Rules: []ProxyRule{{URLPattern: "http://proxied.rodwer.test/*", ProxyServer: proxy.URL}},
+		},
+	})
+	s.Require().NoError(err)
+	defer browser.Close()
+
+	page, err := browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	via := func(url string) string {
+		err := page.Navigate(url)
+		s.Require().NoError(err)
+
+		el, err := page.Element("#via")
+		s.Require().NoError(err)
+		text, err := el.Text()
+		s.Require().NoError(err)
+		return text
+	}
+
+	s.Equal("proxy:proxied.rodwer.test", via("http://proxied.rodwer.test/"), "Matching host should go through the proxy")
+	s.Equal("direct", via(testServer.URL+"/via"), "Other hosts should connect directly")
+}
+
+func (s *BrowserTestSuite) TestCloseWait() {
+	browser, err := NewBrowser(BrowserOptions{Headless: true})
+	s.Require().NoError(err)
+
+	page, err := browser.NewPage()
+	s.Require().NoError(err)
+
+	testHTML := `
+	<html>
+	<body>
+		<div id="spinner" aria-busy="true"></div>
+		<script>
+			setTimeout(function() {
+				document.getElementById('spinner').removeAttribute('aria-busy');
+			}, 500);
+		</script>
+	</body>
+	</html>`
+
+	err = page.Navigate("data:text/html," + testHTML)
+	s.Require().NoError(err)
+
+	// Start a page operation that outlives the call to CloseWait
+	opErr := make(chan error, 1)
+	go func() {
+		opErr <- page.WaitForAttributeGone("#spinner", "aria-busy", 5*time.Second)
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	err = browser.CloseWait(5 * time.Second)
+	s.Require().NoError(err)
+
+	select {
+	case err := <-opErr:
+		s.NoError(err, "Operation should finish before the browser is torn down")
+	case <-time.After(time.Second):
+		s.Fail("Page operation did not report completion")
+	}
+	s.False(browser.IsConnected(), "Browser should be disconnected after CloseWait")
+}
+
+func (s *BrowserTestSuite) TestNewBrowserWithContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	browser, err := NewBrowserWithContext(ctx, BrowserOptions{Headless: true})
+	s.Require().NoError(err)
+	defer browser.Close()
+
+	s.True(browser.IsConnected())
+
+	cancel()
+
+	s.Eventually(func() bool {
+		return !browser.IsConnected()
+	}, 5*time.Second, 50*time.Millisecond, "Cancelling the parent context should close the browser")
+
+	_, err = browser.NewPage()
+	s.Error(err)
+
+	s.Run("cancelled context", func() {
+		_, err := NewBrowserWithContext(ctx, BrowserOptions{Headless: true})
+		s.Require().Error(err)
+		s.ErrorIs(err, context.Canceled)
+	})
+}
+
 // Run the browser test suite
 func TestBrowserSuite(t *testing.T) {
 	suite.Run(t, new(BrowserTestSuite))
@@ -471,7 +733,7 @@ func TestCoverageReport(t *testing.T) {
 	// Use the new coverage reporter
 	reporter := NewCoverageReporter()
 	reporter.SetDebugMode(true)
-	err = reporter.GenerateReport(coverageEntries, indexHTML)
+	err = reporter.GenerateReport(coverageEntries, jsHTML)
 	require.NoError(t, err)
 
 	// Save raw coverage data for compatibility
@@ -0,0 +1,191 @@
+package rodwer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// ConsoleMessage represents a message written to the page's JavaScript console
+type ConsoleMessage struct {
+	Type       string   // "log", "warn", "error", "info", ...
+	Text       string   // arguments joined by a space
+	Args       []string // each argument rendered as DevTools prints it
+	URL        string   // script that logged the message, empty when unknown
+	LineNumber int      // 1-based line in URL, 0 when unknown
+}
+
+// WaitForConsoleMessage waits for a console message whose text contains substring
+func (p *Page) WaitForConsoleMessage(substring string, timeout time.Duration) (ConsoleMessage, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return ConsoleMessage{}, fmt.Errorf("page is closed")
+	}
+
+	defer p.browser.trackOp()()
+
+	ctx, cancel := context.WithTimeout(p.ctx, timeout)
+	defer cancel()
+
+	found := make(chan ConsoleMessage, 1)
+	wait := p.page.Context(ctx).EachEvent(func(e *proto.RuntimeConsoleAPICalled) bool {
+		msg := newConsoleMessage(e)
+		if !strings.Contains(msg.Text, substring) {
+			return false
+		}
+		found <- msg
+		return true
+	})
+	p.listen(wait)
+
+	select {
+	case msg := <-found:
+		return msg, nil
+	case <-ctx.Done():
+		return ConsoleMessage{}, fmt.Errorf("timeout waiting for console message containing %q: %w", substring, ctx.Err())
+	}
+}
+
+// CaptureConsole collects console messages until the returned stop function is called.
+// stop unsubscribes and returns the messages in the order they were logged.
+func (p *Page) CaptureConsole() (stop func() []ConsoleMessage, err error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	ctx, cancel := context.WithCancel(p.ctx)
+
+	var mu sync.Mutex
+	var messages []ConsoleMessage
+	wait := p.page.Context(ctx).EachEvent(func(e *proto.RuntimeConsoleAPICalled) {
+		msg := newConsoleMessage(e)
+		mu.Lock()
+		messages = append(messages, msg)
+		mu.Unlock()
+	})
+	p.listen(wait)
+
+	var once sync.Once
+	return func() []ConsoleMessage {
+		once.Do(cancel)
+
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]ConsoleMessage(nil), messages...)
+	}, nil
+}
+
+// OnConsole calls handler for every console message the page logs, in order, until stop is called
+func (p *Page) OnConsole(handler func(ConsoleMessage)) (stop func()) {
+	ctx, cancel := context.WithCancel(p.ctx)
+
+	wait := p.page.Context(ctx).EachEvent(func(e *proto.RuntimeConsoleAPICalled) {
+		handler(newConsoleMessage(e))
+	})
+	p.listen(wait)
+
+	return cancel
+}
+
+// PageError is an exception the page's JavaScript threw without catching it
+type PageError struct {
+	Message string // e.g. "Error: boom"
+	Stack   string // one "at function (url:line:column)" line per frame, empty when unknown
+}
+
+// Error returns the message followed by the stack
+func (e *PageError) Error() string {
+	if e.Stack == "" {
+		return e.Message
+	}
+	return e.Message + "\n" + e.Stack
+}
+
+// OnPageError calls handler with a *PageError for every uncaught exception on the page until stop is called
+func (p *Page) OnPageError(handler func(error)) (stop func()) {
+	ctx, cancel := context.WithCancel(p.ctx)
+
+	wait := p.page.Context(ctx).EachEvent(func(e *proto.RuntimeExceptionThrown) {
+		handler(newPageError(e.ExceptionDetails))
+	})
+	p.listen(wait)
+
+	return cancel
+}
+
+// newPageError converts CDP exception details into a PageError
+func newPageError(details *proto.RuntimeExceptionDetails) *PageError {
+	pageErr := &PageError{Message: details.Text}
+
+	if exception := details.Exception; exception != nil {
+		text := remoteObjectText(exception)
+		if exception.Type == proto.RuntimeRemoteObjectTypeObject && exception.Description != "" {
+			// Error descriptions carry the stack after the first line
+			text, _, _ = strings.Cut(exception.Description, "\n")
+		}
+		pageErr.Message = text
+	}
+
+	if details.StackTrace != nil {
+		frames := make([]string, 0, len(details.StackTrace.CallFrames))
+		for _, frame := range details.StackTrace.CallFrames {
+			name := frame.FunctionName
+			if name == "" {
+				name = "<anonymous>"
+			}
+			frames = append(frames, fmt.Sprintf("    at %s (%s:%d:%d)", name, frame.URL, frame.LineNumber+1, frame.ColumnNumber+1))
+		}
+		pageErr.Stack = strings.Join(frames, "\n")
+	}
+
+	return pageErr
+}
+
+// newConsoleMessage converts a CDP console event into a ConsoleMessage
+func newConsoleMessage(e *proto.RuntimeConsoleAPICalled) ConsoleMessage {
+	args := make([]string, 0, len(e.Args))
+	for _, arg := range e.Args {
+		args = append(args, remoteObjectText(arg))
+	}
+
+	msg := ConsoleMessage{
+		Type: string(e.Type),
+		Text: strings.Join(args, " "),
+		Args: args,
+	}
+
+	if e.StackTrace != nil && len(e.StackTrace.CallFrames) > 0 {
+		frame := e.StackTrace.CallFrames[0]
+		msg.URL = frame.URL
+		msg.LineNumber = frame.LineNumber + 1
+	}
+
+	return msg
+}
+
+// remoteObjectText renders a console argument the way DevTools prints it
+func remoteObjectText(obj *proto.RuntimeRemoteObject) string {
+	switch {
+	case obj.Type == proto.RuntimeRemoteObjectTypeString:
+		return obj.Value.Str()
+	case obj.Type == proto.RuntimeRemoteObjectTypeUndefined:
+		return "undefined"
+	case obj.UnserializableValue != "":
+		return string(obj.UnserializableValue)
+	case obj.Description != "":
+		return obj.Description
+	default:
+		return obj.Value.JSON("", "")
+	}
+}
@@ -0,0 +1,227 @@
+package rodwer
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/ysmood/gson"
+)
+
+// ConsoleTestSuite covers console and page error observation
+type ConsoleTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *ConsoleTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *ConsoleTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *ConsoleTestSuite) TestWaitForConsoleMessage() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	html := `
+	<html>
+	<body>
+		<script>
+			console.log('booting');
+			setTimeout(function() { console.log('app', 'ready'); }, 300);
+		</script>
+	</body>
+	</html>`
+
+	err = page.Navigate("data:text/html," + html)
+	s.Require().NoError(err)
+
+	s.Run("message logged after delay", func() {
+		msg, err := page.WaitForConsoleMessage("ready", 3*time.Second)
+		s.Require().NoError(err)
+		s.Equal("log", msg.Type)
+		s.Equal("app ready", msg.Text)
+	})
+
+	s.Run("timeout when message never appears", func() {
+		_, err := page.WaitForConsoleMessage("never-logged", 200*time.Millisecond)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "timeout")
+		s.Contains(err.Error(), "never-logged")
+	})
+}
+
+func (s *ConsoleTestSuite) TestCaptureConsole() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/roadmap", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(RoadmapTestHTML()))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	stop, err := page.CaptureConsole()
+	s.Require().NoError(err)
+
+	s.Require().NoError(page.Navigate(testServer.URL + "/roadmap"))
+	_, err = page.WaitForConsoleMessage("Delayed execution", 3*time.Second)
+	s.Require().NoError(err)
+
+	messages := stop()
+
+	var loaded *ConsoleMessage
+	for i := range messages {
+		if messages[i].Text == "Page loaded" {
+			loaded = &messages[i]
+		}
+	}
+	s.Require().NotNil(loaded, "expected 'Page loaded' in %v", messages)
+	s.Equal("log", loaded.Type)
+	s.Equal(testServer.URL+"/roadmap", loaded.URL)
+	s.Positive(loaded.LineNumber)
+
+	s.Run("stop unsubscribes", func() {
+		_, err := page.Evaluate(`() => console.error('after stop')`)
+		s.Require().NoError(err)
+
+		s.Equal(messages, stop(), "No messages are collected after stop")
+	})
+}
+
+func (s *ConsoleTestSuite) TestOnConsole() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.SetContent(`<html><body><h1>Console</h1></body></html>`))
+
+	received := make(chan ConsoleMessage, 10)
+	stop := page.OnConsole(func(msg ConsoleMessage) {
+		received <- msg
+	})
+
+	_, err = page.Evaluate(`() => console.log("hello", 42)`)
+	s.Require().NoError(err)
+
+	select {
+	case msg := <-received:
+		s.Equal("log", msg.Type)
+		s.Equal("hello 42", msg.Text)
+		s.Equal([]string{"hello", "42"}, msg.Args)
+	case <-time.After(3 * time.Second):
+		s.Fail("Handler did not receive the console message")
+	}
+
+	s.Run("stop unsubscribes", func() {
+		stop()
+
+		_, err := page.Evaluate(`() => console.error("after stop")`)
+		s.Require().NoError(err)
+
+		select {
+		case msg := <-received:
+			s.Fail("Handler called after stop", "got %q", msg.Text)
+		case <-time.After(300 * time.Millisecond):
+		}
+	})
+}
+
+func (s *ConsoleTestSuite) TestOnPageError() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.SetContent(`<html><body><h1>Errors</h1></body></html>`))
+
+	received := make(chan error, 10)
+	stop := page.OnPageError(func(err error) {
+		received <- err
+	})
+
+	_, err = page.Evaluate(`() => { setTimeout(function explode() { throw new Error("boom") }, 0) }`)
+	s.Require().NoError(err)
+
+	select {
+	case err := <-received:
+		s.Contains(err.Error(), "boom")
+
+		var pageErr *PageError
+		s.Require().ErrorAs(err, &pageErr)
+		s.Equal("Error: boom", pageErr.Message)
+		s.Contains(pageErr.Stack, "explode")
+	case <-time.After(3 * time.Second):
+		s.Fail("Handler did not receive the page error")
+	}
+
+	s.Run("thrown values", func() {
+		_, err := page.Evaluate(`() => { setTimeout(() => { throw "plain string" }, 0) }`)
+		s.Require().NoError(err)
+
+		select {
+		case err := <-received:
+			s.Contains(err.Error(), "plain string")
+		case <-time.After(3 * time.Second):
+			s.Fail("Handler did not receive the thrown value")
+		}
+	})
+
+	s.Run("stop unsubscribes", func() {
+		stop()
+
+		_, err := page.Evaluate(`() => { setTimeout(() => { throw new Error("after stop") }, 0) }`)
+		s.Require().NoError(err)
+
+		select {
+		case err := <-received:
+			s.Fail("Handler called after stop", "got %v", err)
+		case <-time.After(300 * time.Millisecond):
+		}
+	})
+}
+
+// Run the console test suite
+func TestConsoleSuite(t *testing.T) {
+	suite.Run(t, new(ConsoleTestSuite))
+}
+
+func TestNewPageError(t *testing.T) {
+	err := newPageError(&proto.RuntimeExceptionDetails{
+		Text: "Uncaught",
+		Exception: &proto.RuntimeRemoteObject{
+			Type:        proto.RuntimeRemoteObjectTypeObject,
+			Description: "TypeError: x is undefined\n    at render (app.js:3:5)",
+		},
+		StackTrace: &proto.RuntimeStackTrace{CallFrames: []*proto.RuntimeCallFrame{
+			{FunctionName: "render", URL: "http://localhost/app.js", LineNumber: 2, ColumnNumber: 4},
+			{URL: "http://localhost/app.js", LineNumber: 9, ColumnNumber: 0},
+		}},
+	})
+
+	assert.Equal(t, "TypeError: x is undefined", err.Message)
+	assert.Equal(t, "TypeError: x is undefined\n"+
+		"    at render (http://localhost/app.js:3:5)\n"+
+		"    at <anonymous> (http://localhost/app.js:10:1)", err.Error())
+
+	thrown := newPageError(&proto.RuntimeExceptionDetails{
+		Text:      "Uncaught",
+		Exception: &proto.RuntimeRemoteObject{Type: proto.RuntimeRemoteObjectTypeNumber, Value: gson.New(42), Description: "42"},
+	})
+	assert.Equal(t, "42", thrown.Error())
+}
@@ -1,6 +1,9 @@
 package rodwer
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
 // Test and Coverage Constants
 const (
@@ -40,6 +43,15 @@ const (
 	StabilityPollInterval = 50 * time.Millisecond
 	RetryDelay            = 100 * time.Millisecond
 
+	// Quiet period without requests before the network counts as idle
+	NetworkIdleTime = 500 * time.Millisecond
+
+	// Maximum wait for images before an element screenshot with WaitImages
+	ImageLoadTimeout = 5 * time.Second
+
+	// Maximum wait for the last heap snapshot chunks after the snapshot command returns
+	HeapSnapshotTimeout = 10 * time.Second
+
 	// Test execution delays
 	DOMContentLoadedDelay = 200 * time.Millisecond
 	AsyncJavaScriptDelay  = 200 * time.Millisecond
@@ -56,6 +68,10 @@ const (
 	QuickTestWidth        = 800
 	QuickTestHeight       = 600
 
+	// Largest viewport FitViewportToContent sets, bigger surfaces exceed GPU texture limits
+	MaxViewportWidth  = 16384
+	MaxViewportHeight = 16384
+
 	// Coverage filtering thresholds
 	MinScriptSize            = 30
 	MaxStatementsPerLine     = 50
@@ -97,6 +113,13 @@ const (
 	CoverageCollectionError = "failed to collect coverage"
 )
 
+// Sentinel errors, match them with errors.Is
+var (
+	ErrPageClosed     = errors.New(PageClosedError)
+	ErrNoPreviousPage = errors.New("no previous page in history")
+	ErrNoNextPage     = errors.New("no next page in history")
+)
+
 // Browser launch arguments for different environments
 var (
 	// Standard Chrome arguments for headless testing
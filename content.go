@@ -0,0 +1,120 @@
+package rodwer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// DocumentMIME returns the MIME type of the current document, e.g. "text/html" or "application/json"
+func (p *Page) DocumentMIME() (string, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return "", fmt.Errorf("page is closed")
+	}
+
+	res, err := p.page.Eval(`() => document.contentType`)
+	if err != nil {
+		return "", fmt.Errorf("failed to get document MIME type: %w", err)
+	}
+
+	return res.Value.Str(), nil
+}
+
+// Content returns the current HTML of the document, including changes made by scripts
+func (p *Page) Content() (string, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return "", fmt.Errorf("page is closed")
+	}
+
+	res, err := p.page.Eval(`() => document.documentElement.outerHTML`)
+	if err != nil {
+		return "", fmt.Errorf("failed to get page content: %w", err)
+	}
+
+	return res.Value.Str(), nil
+}
+
+// ScrollHeight returns the scrollable height of the document body in CSS pixels
+func (p *Page) ScrollHeight() (int, error) {
+	return p.bodyDimension("scrollHeight")
+}
+
+// ScrollWidth returns the scrollable width of the document body in CSS pixels
+func (p *Page) ScrollWidth() (int, error) {
+	return p.bodyDimension("scrollWidth")
+}
+
+// bodyDimension reads a size property of document.body, falling back to the root element without a body
+func (p *Page) bodyDimension(property string) (int, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return 0, fmt.Errorf("page is closed")
+	}
+
+	res, err := p.page.Eval(`(property) => (document.body || document.documentElement)[property]`, property)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get %s: %w", property, err)
+	}
+
+	return res.Value.Int(), nil
+}
+
+// SetContentOptions configures SetContent
+type SetContentOptions struct {
+	WaitUntil LoadState // defaults to LoadStateLoad
+}
+
+// SetContent replaces the current document with html without navigating, then waits for the requested load state
+func (p *Page) SetContent(html string, opts ...SetContentOptions) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	var options SetContentOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	if options.WaitUntil == "" {
+		options.WaitUntil = LoadStateLoad
+	}
+
+	if err := validateLoadState(options.WaitUntil); err != nil {
+		return err
+	}
+
+	defer p.browser.trackOp()()
+
+	ctx, cancel := context.WithTimeout(p.ctx, PageLoadTimeout)
+	defer cancel()
+	page := p.page.Context(ctx)
+
+	// Start watching requests before the content can trigger any
+	wait := p.watchLoadState(page, options.WaitUntil)
+
+	err := proto.PageSetDocumentContent{FrameID: page.FrameID, HTML: html}.Call(page)
+	if err != nil {
+		return fmt.Errorf("failed to set document content: %w", err)
+	}
+
+	if err := wait(); err != nil {
+		return fmt.Errorf("failed to wait for %s after setting content: %w", options.WaitUntil, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package rodwer
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ContentTestSuite covers reading and replacing document content
+type ContentTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *ContentTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *ContentTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *ContentTestSuite) TestDocumentMIME() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Run("html document", func() {
+		err := page.Navigate(testServer.URL)
+		s.Require().NoError(err)
+
+		mime, err := page.DocumentMIME()
+		s.Require().NoError(err)
+		s.Equal("text/html", mime)
+	})
+
+	s.Run("json document", func() {
+		err := page.Navigate(`data:application/json,{"ok":true}`)
+		s.Require().NoError(err)
+
+		mime, err := page.DocumentMIME()
+		s.Require().NoError(err)
+		s.Equal("application/json", mime)
+	})
+
+	s.Run("closed page", func() {
+		closedPage, err := s.browser.NewPage()
+		s.Require().NoError(err)
+		s.Require().NoError(closedPage.Close())
+
+		_, err = closedPage.DocumentMIME()
+		s.Error(err)
+	})
+}
+
+func (s *ContentTestSuite) TestContent() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.Navigate("data:text/html,<html><body><h1>Original</h1></body></html>")
+	s.Require().NoError(err)
+
+	_, err = page.Evaluate(`() => {
+		const el = document.createElement('p');
+		el.id = 'injected';
+		el.textContent = 'Added by script';
+		document.body.appendChild(el);
+	}`)
+	s.Require().NoError(err)
+
+	content, err := page.Content()
+	s.Require().NoError(err)
+	s.True(strings.HasPrefix(content, "<html>"))
+	s.Contains(content, "<h1>Original</h1>")
+	s.Contains(content, `<p id="injected">Added by script</p>`)
+
+	s.Run("includes nodes appended by a click", func() {
+		err := page.SetContent(`<html><body>
+			<ul id="list"></ul>
+			<button id="add" onclick="const li = document.createElement('li'); li.className = 'item'; li.textContent = 'Clicked'; document.getElementById('list').appendChild(li)">Add</button>
+		</body></html>`)
+		s.Require().NoError(err)
+
+		button, err := page.Element("#add")
+		s.Require().NoError(err)
+		s.Require().NoError(button.Click())
+
+		content, err := page.Content()
+		s.Require().NoError(err)
+		s.Contains(content, `<ul id="list"><li class="item">Clicked</li></ul>`)
+	})
+
+	s.Run("round trips through SetContent", func() {
+		err := page.SetContent(content)
+		s.Require().NoError(err)
+
+		again, err := page.Content()
+		s.Require().NoError(err)
+		s.Equal(content, again)
+	})
+
+	s.Run("closed page", func() {
+		closedPage, err := s.browser.NewPage()
+		s.Require().NoError(err)
+		s.Require().NoError(closedPage.Close())
+
+		_, err = closedPage.Content()
+		s.Error(err)
+	})
+}
+
+func (s *ContentTestSuite) TestScrollDimensions() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><head><style>
+		body { margin: 0; min-height: 3000px; }
+		#wide { width: 2500px; height: 10px; }
+	</style></head><body><div id="wide"></div></body></html>`)
+	s.Require().NoError(err)
+
+	height, err := page.ScrollHeight()
+	s.Require().NoError(err)
+	s.GreaterOrEqual(height, 3000)
+
+	width, err := page.ScrollWidth()
+	s.Require().NoError(err)
+	s.GreaterOrEqual(width, 2500)
+
+	s.Run("closed page", func() {
+		s.Require().NoError(page.Close())
+
+		_, err := page.ScrollHeight()
+		s.Error(err)
+	})
+}
+
+func (s *ContentTestSuite) TestSetContent() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/api/late", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+		w.Write([]byte("late"))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	heading := func() string {
+		el, err := page.Element("h1")
+		s.Require().NoError(err)
+		text, err := el.Text()
+		s.Require().NoError(err)
+		return text
+	}
+
+	s.Run("characters that break data URLs", func() {
+		err := page.SetContent(`<html><body><h1>100% #1 ` + strings.Repeat("x", 100000) + `</h1></body></html>`)
+		s.Require().NoError(err)
+		s.True(strings.HasPrefix(heading(), "100% #1 "))
+	})
+
+	s.Run("wait until domcontentloaded", func() {
+		err := page.SetContent(`<html><body><h1>Parsed</h1></body></html>`, SetContentOptions{WaitUntil: LoadStateDOMContentLoaded})
+		s.Require().NoError(err)
+		s.Equal("Parsed", heading())
+	})
+
+	s.Run("wait until networkidle", func() {
+		// Relative URLs resolve against the current document, so start from the test server
+		s.Require().NoError(page.Navigate(testServer.URL))
+
+		html := `<html><body><h1>Idle</h1><div id="late"></div>
+			<script>fetch('/api/late').then(r => r.text()).then(t => { document.getElementById('late').textContent = t; });</script>
+		</body></html>`
+		err := page.SetContent(html, SetContentOptions{WaitUntil: LoadStateNetworkIdle})
+		s.Require().NoError(err)
+
+		el, err := page.Element("#late")
+		s.Require().NoError(err)
+		text, err := el.Text()
+		s.Require().NoError(err)
+		s.Equal("late", text, "The fetch should have finished before SetContent returned")
+	})
+
+	s.Run("unsupported load state", func() {
+		err := page.SetContent(`<html></html>`, SetContentOptions{WaitUntil: "commit"})
+		s.Error(err)
+	})
+}
+
+// Run the content test suite
+func TestContentSuite(t *testing.T) {
+	suite.Run(t, new(ContentTestSuite))
+}
@@ -0,0 +1,177 @@
+package rodwer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// Cookie represents a browser cookie
+type Cookie struct {
+	Name     string
+	Value    string
+	Domain   string
+	Path     string
+	Expires  time.Time // zero for session cookies
+	HTTPOnly bool
+	Secure   bool
+	SameSite string // "Strict", "Lax", "None" or empty
+}
+
+// CookiesForURL returns the cookies the browser would send to url, regardless of which pages are open
+func (b *Browser) CookiesForURL(url string) ([]Cookie, error) {
+	b.mu.RLock()
+	closed := b.closed
+	b.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("browser is closed")
+	}
+
+	// Network.getCookies needs a page session, any page shares the browser's cookie jar
+	rodPage, release, err := b.anyPage()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := proto.NetworkGetCookies{Urls: []string{url}}.Call(rodPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cookies for %s: %w", url, err)
+	}
+
+	cookies := make([]Cookie, 0, len(res.Cookies))
+	for _, c := range res.Cookies {
+		cookies = append(cookies, newCookie(c))
+	}
+
+	return cookies, nil
+}
+
+// GetCookies returns the cookies of the page's current URL
+func (p *Page) GetCookies() ([]*Cookie, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	res, err := proto.NetworkGetCookies{}.Call(p.page.Context(p.ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cookies: %w", err)
+	}
+
+	cookies := make([]*Cookie, 0, len(res.Cookies))
+	for _, c := range res.Cookies {
+		cookie := newCookie(c)
+		cookies = append(cookies, &cookie)
+	}
+
+	return cookies, nil
+}
+
+// SetCookies stores cookies in the browser. Cookies without a Domain are set for the page's current URL.
+func (p *Page) SetCookies(cookies []*Cookie) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	url := p.URL()
+	params := make([]*proto.NetworkCookieParam, 0, len(cookies))
+	for i, c := range cookies {
+		if c == nil {
+			return fmt.Errorf("cookie %d is nil", i)
+		}
+
+		param := &proto.NetworkCookieParam{
+			Name:     c.Name,
+			Value:    c.Value,
+			Domain:   c.Domain,
+			Path:     c.Path,
+			HTTPOnly: c.HTTPOnly,
+			Secure:   c.Secure,
+			SameSite: proto.NetworkCookieSameSite(c.SameSite),
+		}
+		if c.Domain == "" {
+			param.URL = url
+		}
+		if !c.Expires.IsZero() {
+			param.Expires = proto.TimeSinceEpoch(c.Expires.Unix())
+		}
+		params = append(params, param)
+	}
+
+	if err := (proto.NetworkSetCookies{Cookies: params}).Call(p.page.Context(p.ctx)); err != nil {
+		return fmt.Errorf("failed to set cookies: %w", err)
+	}
+
+	return nil
+}
+
+// ClearCookies deletes the cookies of the page's current URL, cookies of other sites are kept
+func (p *Page) ClearCookies() error {
+	cookies, err := p.GetCookies()
+	if err != nil {
+		return err
+	}
+
+	page := p.page.Context(p.ctx)
+	for _, c := range cookies {
+		err := proto.NetworkDeleteCookies{Name: c.Name, Domain: c.Domain, Path: c.Path}.Call(page)
+		if err != nil {
+			return fmt.Errorf("failed to delete cookie %s: %w", c.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// anyPage returns an open page of the browser, creating a blank one when none exists
+func (b *Browser) anyPage() (*rod.Page, func(), error) {
+	b.mu.RLock()
+	browser := b.browser
+	b.mu.RUnlock()
+
+	rodPages, err := browser.Pages()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get pages: %w", err)
+	}
+
+	if len(rodPages) > 0 {
+		return rodPages.First(), func() {}, nil
+	}
+
+	rodPage, err := browser.Page(proto.TargetCreateTarget{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create page: %w", err)
+	}
+
+	return rodPage, func() { _ = rodPage.Close() }, nil
+}
+
+// newCookie converts a CDP cookie into a Cookie
+func newCookie(c *proto.NetworkCookie) Cookie {
+	cookie := Cookie{
+		Name:     c.Name,
+		Value:    c.Value,
+		Domain:   c.Domain,
+		Path:     c.Path,
+		HTTPOnly: c.HTTPOnly,
+		Secure:   c.Secure,
+		SameSite: string(c.SameSite),
+	}
+
+	if !c.Session {
+		cookie.Expires = c.Expires.Time()
+	}
+
+	return cookie
+}
@@ -0,0 +1,143 @@
+package rodwer
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/stretchr/testify/suite"
+)
+
+// CookieTestSuite covers cookie inspection and management
+type CookieTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *CookieTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *CookieTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *CookieTestSuite) TestCookiesForURL() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.Navigate("data:text/html,<html><body><h1>App</h1></body></html>")
+	s.Require().NoError(err)
+
+	// The cookie belongs to an API host unrelated to the page URL
+	err = s.browser.browser.SetCookies([]*proto.NetworkCookieParam{{
+		Name:     "auth",
+		Value:    "token-123",
+		URL:      "http://api.rodwer.localhost/",
+		HTTPOnly: true,
+	}})
+	s.Require().NoError(err)
+
+	s.Run("cookie is visible for its own URL", func() {
+		cookies, err := s.browser.CookiesForURL("http://api.rodwer.localhost/v1/session")
+		s.Require().NoError(err)
+		s.Require().Len(cookies, 1)
+		s.Equal("auth", cookies[0].Name)
+		s.Equal("token-123", cookies[0].Value)
+		s.Equal("api.rodwer.localhost", cookies[0].Domain)
+		s.True(cookies[0].HTTPOnly)
+		s.True(cookies[0].Expires.IsZero(), "Session cookies have no expiry")
+	})
+
+	s.Run("cookie is not sent to other hosts", func() {
+		cookies, err := s.browser.CookiesForURL("http://www.rodwer.localhost/")
+		s.Require().NoError(err)
+		s.Empty(cookies)
+	})
+}
+
+func (s *CookieTestSuite) TestPageCookies() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/whoami", func(w http.ResponseWriter, r *http.Request) {
+		session, err := r.Cookie("session")
+		if err != nil {
+			w.Write([]byte("anonymous"))
+			return
+		}
+		w.Write([]byte(session.Value))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL))
+
+	whoami := func() string {
+		res, err := page.Evaluate(`() => fetch('/whoami').then(r => r.text())`)
+		s.Require().NoError(err)
+		return res.(string)
+	}
+
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+	err = page.SetCookies([]*Cookie{
+		{Name: "session", Value: "user-42", HTTPOnly: true, SameSite: "Lax", Expires: expires},
+		{Name: "theme", Value: "dark", Path: "/"},
+	})
+	s.Require().NoError(err)
+
+	s.Run("cookies are sent without logging in", func() {
+		s.Equal("user-42", whoami())
+	})
+
+	s.Run("get cookies", func() {
+		cookies, err := page.GetCookies()
+		s.Require().NoError(err)
+		s.Require().Len(cookies, 2)
+
+		byName := map[string]*Cookie{}
+		for _, c := range cookies {
+			byName[c.Name] = c
+		}
+
+		session := byName["session"]
+		s.Require().NotNil(session)
+		s.Equal("user-42", session.Value)
+		s.Equal("127.0.0.1", session.Domain)
+		s.True(session.HTTPOnly)
+		s.Equal("Lax", session.SameSite)
+		s.True(expires.Equal(session.Expires), "expected %s, got %s", expires, session.Expires)
+
+		s.Require().NotNil(byName["theme"])
+		s.True(byName["theme"].Expires.IsZero())
+	})
+
+	s.Run("nil cookie", func() {
+		err := page.SetCookies([]*Cookie{{Name: "a", Value: "b"}, nil})
+		s.EqualError(err, "cookie 1 is nil")
+	})
+
+	s.Run("clear cookies", func() {
+		s.Require().NoError(page.ClearCookies())
+
+		cookies, err := page.GetCookies()
+		s.Require().NoError(err)
+		s.Empty(cookies)
+		s.Equal("anonymous", whoami())
+	})
+}
+
+// Run the cookie test suite
+func TestCookieSuite(t *testing.T) {
+	suite.Run(t, new(CookieTestSuite))
+}
@@ -0,0 +1,247 @@
+package rodwer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// ElementCoverage is the coverage of the scripts inside an element
+type ElementCoverage struct {
+	Scripts   []CoverageEntry
+	Aggregate CoverageMetrics
+}
+
+// CoverageForElement returns the current coverage of the <script> tags in the subtree of the element matching selector.
+// Coverage must have been started with StartJSCoverage, it keeps running afterwards.
+func (p *Page) CoverageForElement(selector string) (*ElementCoverage, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	defer p.browser.trackOp()()
+
+	page := p.page.Context(p.ctx)
+
+	el, err := page.Element(selector)
+	if err != nil {
+		return nil, fmt.Errorf("element not found: %s", selector)
+	}
+
+	res, err := el.Eval(`() => {
+		const scripts = Array.from(this.querySelectorAll('script'));
+		if (this.tagName === 'SCRIPT') scripts.unshift(this);
+		return scripts.map(s => ({ src: s.src, text: s.textContent }));
+	}`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find scripts in %s: %w", selector, err)
+	}
+
+	snapshot, err := proto.ProfilerTakePreciseCoverage{}.Call(page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take coverage snapshot: %w", err)
+	}
+
+	coverage := &ElementCoverage{Scripts: []CoverageEntry{}}
+	var metrics []CoverageMetrics
+	for _, script := range snapshot.Result {
+		srcResp, err := proto.DebuggerGetScriptSource{ScriptID: script.ScriptID}.Call(page)
+		if err != nil || srcResp.ScriptSource == "" {
+			continue
+		}
+
+		// External scripts match by URL, inline scripts by their text
+		matched := false
+		for _, tag := range res.Value.Arr() {
+			src := tag.Get("src").Str()
+			if (src != "" && src == script.URL) || (src == "" && tag.Get("text").Str() == srcResp.ScriptSource) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		coverage.Scripts = append(coverage.Scripts, newCoverageEntry(script, srcResp.ScriptSource))
+		metrics = append(metrics, calculateCoverageMetrics(srcResp.ScriptSource, flattenCoverageRanges(script), script.Functions))
+	}
+
+	coverage.Aggregate = aggregateCoverageMetrics(metrics)
+	return coverage, nil
+}
+
+// CoverageSummary is the line coverage of a single script
+type CoverageSummary struct {
+	LineCount        int
+	CoveredLineCount int
+	CoveredPercent   float64
+}
+
+// Summarize computes the entry's line coverage, stores it in the entry's summary fields and returns it.
+// A line counts as covered when any of its characters ran; ranges are applied in order so nested unexecuted blocks stay uncovered.
+// Range offsets are in UTF-16 code units, as V8 reports them.
+func (e *CoverageEntry) Summarize() CoverageSummary {
+	source := strings.TrimSuffix(e.Source, "\n")
+	if source == "" {
+		e.LineCount, e.CoveredLineCount, e.CoveredPercent = 0, 0, 0
+		return CoverageSummary{}
+	}
+
+	covered := make([]bool, len(utf16.Encode([]rune(source))))
+	for _, r := range e.Ranges {
+		for i := max(r.Start, 0); i < r.End && i < len(covered); i++ {
+			covered[i] = r.Count > 0
+		}
+	}
+
+	lines := strings.Split(source, "\n")
+	coveredLines := 0
+	offset := 0
+	for _, line := range lines {
+		width := len(utf16.Encode([]rune(line)))
+		for i := offset; i < offset+width; i++ {
+			if covered[i] {
+				coveredLines++
+				break
+			}
+		}
+		offset += width + 1
+	}
+
+	e.LineCount = len(lines)
+	e.CoveredLineCount = coveredLines
+	e.CoveredPercent = calculatePct(coveredLines, len(lines))
+
+	return CoverageSummary{
+		LineCount:        e.LineCount,
+		CoveredLineCount: e.CoveredLineCount,
+		CoveredPercent:   e.CoveredPercent,
+	}
+}
+
+// newCoverageEntry converts a CDP script coverage and its source into a CoverageEntry
+func newCoverageEntry(script *proto.ProfilerScriptCoverage, source string) CoverageEntry {
+	ranges := make([]CoverageRange, 0)
+	for _, r := range flattenCoverageRanges(script) {
+		ranges = append(ranges, CoverageRange{
+			Start: r.StartOffset,
+			End:   r.EndOffset,
+			Count: r.Count,
+		})
+	}
+
+	// Handle empty URLs for inline scripts or data URLs
+	url := script.URL
+	if url == "" {
+		url = fmt.Sprintf("inline-script-%s", script.ScriptID)
+	}
+
+	return CoverageEntry{
+		URL:    url,
+		Source: source,
+		Ranges: ranges,
+	}
+}
+
+// flattenCoverageRanges collects the ranges of all functions of a script
+func flattenCoverageRanges(script *proto.ProfilerScriptCoverage) []*proto.ProfilerCoverageRange {
+	var ranges []*proto.ProfilerCoverageRange
+	for _, fn := range script.Functions {
+		ranges = append(ranges, fn.Ranges...)
+	}
+	return ranges
+}
+
+// aggregateCoverageMetrics sums statement, function and line counts and recomputes the percentages
+func aggregateCoverageMetrics(metrics []CoverageMetrics) CoverageMetrics {
+	var total CoverageMetrics
+	for _, m := range metrics {
+		total.Statements.Total += m.Statements.Total
+		total.Statements.Covered += m.Statements.Covered
+		total.Functions.Total += m.Functions.Total
+		total.Functions.Covered += m.Functions.Covered
+		total.Lines.Total += m.Lines.Total
+		total.Lines.Covered += m.Lines.Covered
+	}
+
+	total.Statements.Pct = calculatePct(total.Statements.Covered, total.Statements.Total)
+	total.Functions.Pct = calculatePct(total.Functions.Covered, total.Functions.Total)
+	total.Lines.Pct = calculatePct(total.Lines.Covered, total.Lines.Total)
+
+	return total
+}
+
+// urlFilter is a compiled StartJSCoverageOptions.URLFilter
+type urlFilter []*regexp.Regexp
+
+// compileURLFilter compiles the glob patterns once, so matching many scripts doesn't recompile them
+func compileURLFilter(patterns []string) urlFilter {
+	filter := make(urlFilter, 0, len(patterns))
+	for _, pattern := range patterns {
+		filter = append(filter, globToRegexp(pattern))
+	}
+	return filter
+}
+
+// matches reports whether url matches one of the patterns, an empty filter matches everything
+func (f urlFilter) matches(url string) bool {
+	if len(f) == 0 {
+		return true
+	}
+
+	for _, pattern := range f {
+		if pattern.MatchString(url) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// collectCoverageEntries converts the scripts matching filter into coverage entries. Scripts outside the
+// filter are dropped before source is asked for their code, scripts without source are skipped.
+func collectCoverageEntries(scripts []*proto.ProfilerScriptCoverage, filter urlFilter, source func(proto.RuntimeScriptID) (string, error)) []CoverageEntry {
+	entries := make([]CoverageEntry, 0)
+
+	for _, script := range scripts {
+		if !filter.matches(script.URL) {
+			continue
+		}
+
+		code, err := source(script.ScriptID)
+		if err != nil || code == "" {
+			continue
+		}
+
+		entries = append(entries, newCoverageEntry(script, code))
+	}
+
+	return entries
+}
+
+// globToRegexp compiles a glob where "*" matches any characters, including "/", and "?" matches one
+func globToRegexp(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+
+	return regexp.MustCompile(b.String())
+}
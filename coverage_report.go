@@ -3,6 +3,7 @@ package rodwer
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"text/template"
@@ -54,7 +55,9 @@ type FileEntry struct {
 // CoverageReporter handles JavaScript coverage report generation
 type CoverageReporter struct {
 	filterOptions CoverageFilterOptions
+	customFilter  func(url, source string) bool
 	debugMode     bool
+	outputPath    string
 }
 
 // NewCoverageReporter creates a new coverage reporter
@@ -62,9 +65,15 @@ func NewCoverageReporter() *CoverageReporter {
 	return &CoverageReporter{
 		filterOptions: getFilterOptions("application"),
 		debugMode:     false,
+		outputPath:    JSCoverageHTML,
 	}
 }
 
+// SetOutputPath sets the file GenerateReportFromPage writes to and GenerateReport uses when no path is given
+func (cr *CoverageReporter) SetOutputPath(path string) {
+	cr.outputPath = path
+}
+
 // SetDebugMode enables/disables debug logging
 func (cr *CoverageReporter) SetDebugMode(enabled bool) {
 	cr.debugMode = enabled
@@ -75,8 +84,30 @@ func (cr *CoverageReporter) SetFilterProfile(profile string) {
 	cr.filterOptions = getFilterOptions(profile)
 }
 
-// GenerateReport generates a complete coverage report
+// SetCustomFilter replaces the built-in filter rules with fn, which reports whether a script is kept.
+// Passing nil restores the rules from the active filter profile.
+func (cr *CoverageReporter) SetCustomFilter(fn func(url, source string) bool) {
+	cr.customFilter = fn
+}
+
+// filterScript applies the custom filter when set, otherwise the filter profile rules
+func (cr *CoverageReporter) filterScript(script *proto.ProfilerScriptCoverage, source string) (bool, string) {
+	if cr.customFilter == nil {
+		return isApplicationScript(script, source, cr.filterOptions)
+	}
+
+	if cr.customFilter(script.URL, source) {
+		return true, "custom_include"
+	}
+	return false, "custom_exclude"
+}
+
+// GenerateReport writes the coverage report to outputPath, or to the configured output path when empty
 func (cr *CoverageReporter) GenerateReport(entries []CoverageEntry, outputPath string) error {
+	if outputPath == "" {
+		outputPath = cr.outputPath
+	}
+
 	// Convert to old format for compatibility
 	oldFormat := cr.convertToOldCoverageFormat(entries)
 
@@ -90,16 +121,11 @@ func (cr *CoverageReporter) GenerateReport(entries []CoverageEntry, outputPath s
 		}
 	}
 
-	cr.generateJSReportUnified(oldFormat, sourceProvider, outputFunc)
-
-	// Calculate coverage percentage
-	jsPct := cr.computeJavaScriptCoverageFromEntries(entries)
-
-	// Generate index file
-	return cr.generateCoverageIndex(jsPct, outputPath)
+	_, err := cr.generateJSReportUnified(oldFormat, sourceProvider, outputPath, outputFunc)
+	return err
 }
 
-// GenerateReportFromPage generates a report directly from a Rod page
+// GenerateReportFromPage writes a report for a Rod page to the configured output path
 func (cr *CoverageReporter) GenerateReportFromPage(page *rod.Page, raw []*proto.ProfilerScriptCoverage) FilteringStats {
 	sourceProvider := func(index int, script *proto.ProfilerScriptCoverage) (string, error) {
 		srcResp, err := proto.DebuggerGetScriptSource{ScriptID: script.ScriptID}.Call(page)
@@ -119,7 +145,106 @@ func (cr *CoverageReporter) GenerateReportFromPage(page *rod.Page, raw []*proto.
 		}
 	}
 
-	return cr.generateJSReportUnified(raw, sourceProvider, outputFunc)
+	stats, err := cr.generateJSReportUnified(raw, sourceProvider, cr.outputPath, outputFunc)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+	}
+
+	return stats
+}
+
+// CoverageReportLink is a report listed on the coverage index
+type CoverageReportLink struct {
+	Label string // link text, e.g. "JavaScript coverage"
+	Path  string // report file, linked relative to the index
+}
+
+// coverageIndexTemplate renders the coverage index page
+const coverageIndexTemplate = `<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Coverage Reports</title></head>
+<body>
+	<h1>Coverage Reports</h1>
+	<p>Generated {{.Timestamp}}</p>
+	<ul>
+{{- range .Reports}}
+		<li><a href="{{.Href | html}}">{{.Label | html}}</a></li>
+{{- end}}
+	</ul>
+</body></html>
+`
+
+// GenerateCoverageIndex writes a page linking reports to outputPath, CoverageIndexHTML when empty
+func (cr *CoverageReporter) GenerateCoverageIndex(outputPath string, reports []CoverageReportLink) error {
+	if outputPath == "" {
+		outputPath = CoverageIndexHTML
+	}
+
+	if len(reports) == 0 {
+		return fmt.Errorf("coverage index needs at least one report")
+	}
+
+	type indexLink struct {
+		Label string
+		Href  string
+	}
+
+	links := make([]indexLink, len(reports))
+	for i, report := range reports {
+		if report.Path == "" {
+			return fmt.Errorf("coverage report %q has no path", report.Label)
+		}
+		label := report.Label
+		if label == "" {
+			label = filepath.Base(report.Path)
+		}
+		links[i] = indexLink{Label: label, Href: indexHref(filepath.Dir(outputPath), report.Path)}
+	}
+
+	var buf strings.Builder
+	err := template.Must(template.New("index").Parse(coverageIndexTemplate)).Execute(&buf, struct {
+		Timestamp string
+		Reports   []indexLink
+	}{
+		Timestamp: time.Now().Format("2006-01-02 15:04:05"),
+		Reports:   links,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render coverage index: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return fmt.Errorf("failed to create coverage index directory: %w", err)
+	}
+
+	if err := os.WriteFile(outputPath, []byte(buf.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write coverage index %s: %w", outputPath, err)
+	}
+
+	return nil
+}
+
+// indexHref returns the link to path from a page in dir, URLs are kept as they are
+func indexHref(dir, path string) string {
+	if strings.Contains(path, "://") {
+		return path
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+
+	rel, err := filepath.Rel(absDir, absPath)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+
+	return filepath.ToSlash(rel)
 }
 
 // convertToOldCoverageFormat converts new CoverageEntry to old format for compatibility
@@ -170,46 +295,9 @@ func (cr *CoverageReporter) createSourceProviderFromEntries(entries []CoverageEn
 	}
 }
 
-// computeJavaScriptCoverageFromEntries computes coverage percentage from new format
-func (cr *CoverageReporter) computeJavaScriptCoverageFromEntries(entries []CoverageEntry) float64 {
-	totalBytes := 0
-	coveredBytes := 0
-
-	for _, entry := range entries {
-		if entry.Source == "" {
-			continue
-		}
-
-		totalBytes += len(entry.Source)
-
-		// Calculate covered bytes from ranges
-		covered := make([]bool, len(entry.Source))
-		for _, r := range entry.Ranges {
-			if r.Count > 0 && r.Start >= 0 && r.End <= len(entry.Source) {
-				for i := r.Start; i < r.End; i++ {
-					covered[i] = true
-				}
-			}
-		}
-
-		for _, c := range covered {
-			if c {
-				coveredBytes++
-			}
-		}
-	}
-
-	if totalBytes == 0 {
-		return 0
-	}
-
-	return float64(coveredBytes) / float64(totalBytes) * 100
-}
-
-// generateJSReportUnified generates Istanbul.js-style report with flexible source fetching
-func (cr *CoverageReporter) generateJSReportUnified(raw []*proto.ProfilerScriptCoverage, sourceProvider SourceProvider, outputFunc func(string, ...interface{})) FilteringStats {
+// generateJSReportUnified writes an Istanbul.js-style report to outputPath with flexible source fetching
+func (cr *CoverageReporter) generateJSReportUnified(raw []*proto.ProfilerScriptCoverage, sourceProvider SourceProvider, outputPath string, outputFunc func(string, ...interface{})) (FilteringStats, error) {
 	entries := make([]FileEntry, 0, len(raw))
-	var totalMetrics CoverageMetrics
 	var filterStats FilteringStats
 
 	filterStats.TotalScripts = len(raw)
@@ -225,7 +313,7 @@ func (cr *CoverageReporter) generateJSReportUnified(raw []*proto.ProfilerScriptC
 		}
 
 		// Apply filtering logic
-		isApp, reason := isApplicationScript(r, scriptSource, cr.filterOptions)
+		isApp, reason := cr.filterScript(r, scriptSource)
 		filterStats.FilterReasons[reason]++
 
 		if !isApp {
@@ -264,65 +352,38 @@ func (cr *CoverageReporter) generateJSReportUnified(raw []*proto.ProfilerScriptC
 		}
 
 		entries = append(entries, entry)
-
-		// Add to total metrics
-		totalMetrics.Statements.Total += metrics.Statements.Total
-		totalMetrics.Statements.Covered += metrics.Statements.Covered
-		totalMetrics.Functions.Total += metrics.Functions.Total
-		totalMetrics.Functions.Covered += metrics.Functions.Covered
-		totalMetrics.Lines.Total += metrics.Lines.Total
-		totalMetrics.Lines.Covered += metrics.Lines.Covered
 	}
 
 	// Calculate final filtering statistics
 	filterStats.ApplicationScripts = len(entries)
 	filterStats.FilteredOut = filterStats.TotalScripts - filterStats.ApplicationScripts
 
-	// Calculate total percentages
-	if totalMetrics.Statements.Total > 0 {
-		totalMetrics.Statements.Pct = float64(totalMetrics.Statements.Covered) / float64(totalMetrics.Statements.Total) * 100
-	}
-	if totalMetrics.Functions.Total > 0 {
-		totalMetrics.Functions.Pct = float64(totalMetrics.Functions.Covered) / float64(totalMetrics.Functions.Total) * 100
-	}
-	if totalMetrics.Lines.Total > 0 {
-		totalMetrics.Lines.Pct = float64(totalMetrics.Lines.Covered) / float64(totalMetrics.Lines.Total) * 100
+	// Calculate total metrics
+	metrics := make([]CoverageMetrics, len(entries))
+	for i, entry := range entries {
+		metrics[i] = entry.Metrics
 	}
+	totalMetrics := aggregateCoverageMetrics(metrics)
 
 	sort.Slice(entries, func(i, j int) bool { return entries[i].URL < entries[j].URL })
 
 	html := generateIstanbulStyleHTML(entries, totalMetrics, filterStats)
 
-	jsHTML := "coverage/js-coverage.html"
-	_ = os.WriteFile(jsHTML, []byte(html), 0644)
-
-	outputFunc("JavaScript coverage report written to %s", jsHTML)
-	outputFunc("Coverage Summary - Statements: %.1f%%, Functions: %.1f%%, Lines: %.1f%%",
-		totalMetrics.Statements.Pct, totalMetrics.Functions.Pct, totalMetrics.Lines.Pct)
-
-	return filterStats
-}
+	if dir := filepath.Dir(outputPath); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return filterStats, fmt.Errorf("failed to create coverage report directory: %w", err)
+		}
+	}
 
-// generateCoverageIndex generates the main coverage index HTML file
-func (cr *CoverageReporter) generateCoverageIndex(jsPct float64, outputPath string) error {
-	if outputPath == "" {
-		outputPath = "coverage/index.html"
+	if err := os.WriteFile(outputPath, []byte(html), 0644); err != nil {
+		return filterStats, fmt.Errorf("failed to write coverage report %s: %w", outputPath, err)
 	}
 
-	content := fmt.Sprintf(`<!DOCTYPE html>
-<html><head><title>Unified Coverage Report</title></head>
-<body>
-	<h1>Unified Coverage Report</h1>
-	<h2>Coverage Summary</h2>
-	<p>JavaScript Coverage: %.1f%%</p>
-	<ul>
-		<li><a href="js-coverage.html">✅ JavaScript Coverage Report</a></li>
-		<li><a href="screenshot-page.png">🖼️ Screenshot - Initial</a></li>
-		<li><a href="screenshot-after-click.png">🖼️ Screenshot - After Copy Click</a></li>
-	</ul>
-</body></html>`, jsPct)
+	outputFunc("JavaScript coverage report written to %s", outputPath)
+	outputFunc("Coverage Summary - Statements: %.1f%%, Functions: %.1f%%, Lines: %.1f%%",
+		totalMetrics.Statements.Pct, totalMetrics.Functions.Pct, totalMetrics.Lines.Pct)
 
-	return os.WriteFile(outputPath, []byte(content), 0644)
+	return filterStats, nil
 }
 
 // HTML Report Generation
@@ -0,0 +1,184 @@
+package rodwer
+
+import (
+	"encoding/base64"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCoverageEntries returns coverage entries for an application script and a vendor script
+func testCoverageEntries() []CoverageEntry {
+	return []CoverageEntry{
+		{
+			URL:    "http://localhost/app.js",
+			Source: "function appMain() {\n  return 'application code';\n}\nappMain();",
+			Ranges: []CoverageRange{{Start: 0, End: 60, Count: 1}},
+		},
+		{
+			URL:    "http://localhost/vendor.js",
+			Source: "function vendorHelper() {\n  return 'third party code';\n}",
+			Ranges: []CoverageRange{{Start: 0, End: 20, Count: 1}},
+		},
+	}
+}
+
+func TestCoverageReporterCustomFilter(t *testing.T) {
+	// The reporter writes into coverage/, keep it away from the real report
+	t.Chdir(t.TempDir())
+
+	entries := testCoverageEntries()
+	noop := func(string, ...interface{}) {}
+
+	t.Run("custom filter keeps only matching scripts", func(t *testing.T) {
+		reporter := NewCoverageReporter()
+		reporter.SetCustomFilter(func(url, source string) bool {
+			return strings.Contains(url, "app")
+		})
+
+		raw := reporter.convertToOldCoverageFormat(entries)
+		stats, err := reporter.generateJSReportUnified(raw, reporter.createSourceProviderFromEntries(entries), JSCoverageHTML, noop)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, stats.TotalScripts)
+		assert.Equal(t, 1, stats.ApplicationScripts)
+		assert.Equal(t, 1, stats.FilteredOut)
+		assert.Equal(t, 1, stats.FilterReasons["custom_include"])
+		assert.Equal(t, 1, stats.FilterReasons["custom_exclude"])
+	})
+
+	t.Run("custom filter bypasses built-in rules", func(t *testing.T) {
+		reporter := NewCoverageReporter()
+		reporter.SetCustomFilter(func(url, source string) bool { return true })
+
+		// Too small for every built-in profile
+		tiny := []CoverageEntry{{URL: "http://localhost/app-tiny.js", Source: "a()", Ranges: []CoverageRange{{Start: 0, End: 3, Count: 1}}}}
+		raw := reporter.convertToOldCoverageFormat(tiny)
+		stats, err := reporter.generateJSReportUnified(raw, reporter.createSourceProviderFromEntries(tiny), JSCoverageHTML, noop)
+		require.NoError(t, err)
+
+		require.Equal(t, 1, stats.ApplicationScripts)
+		assert.Zero(t, stats.FilterReasons["too_small"])
+	})
+
+	t.Run("nil filter restores profile rules", func(t *testing.T) {
+		reporter := NewCoverageReporter()
+		reporter.SetCustomFilter(func(url, source string) bool { return false })
+		reporter.SetCustomFilter(nil)
+
+		isApp, reason := reporter.filterScript(&proto.ProfilerScriptCoverage{URL: entries[0].URL}, entries[0].Source)
+		assert.True(t, isApp)
+		assert.Equal(t, "application_script", reason)
+	})
+}
+
+func TestCoverageReportTypeScriptSourceMap(t *testing.T) {
+	sourceMap := base64.StdEncoding.EncodeToString([]byte(`{"version":3,"sources":["src/app.ts"],"mappings":"AAAA"}`))
+	source := "function greet(name) {\n  return 'hi ' + name;\n}\n//# sourceMappingURL=data:application/json;charset=utf-8;base64," + sourceMap
+	entry := FileEntry{
+		ScriptID: "7",
+		URL:      "http://localhost/bundle.js#7",
+		Source:   source,
+		Lines:    strings.Split(source, "\n"),
+	}
+
+	details := generateFileDetails([]FileEntry{entry})
+	assert.Contains(t, details, "src/app.ts")
+	assert.Contains(t, details, `class="language-typescript"`)
+	assert.NotContains(t, details, "language-javascript")
+
+	plain := FileEntry{ScriptID: "8", URL: "http://localhost/app.js", Source: "run();", Lines: []string{"run();"}}
+	details = generateFileDetails([]FileEntry{plain})
+	assert.Contains(t, details, "http://localhost/app.js")
+	assert.Contains(t, details, `class="language-javascript"`)
+}
+
+func TestCoverageReportOutputPath(t *testing.T) {
+	entries := testCoverageEntries()
+
+	// writtenFiles lists the files below the working directory
+	writtenFiles := func(t *testing.T) []string {
+		var files []string
+		err := filepath.WalkDir(".", func(path string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() {
+				files = append(files, filepath.ToSlash(path))
+			}
+			return err
+		})
+		require.NoError(t, err)
+		return files
+	}
+
+	t.Run("explicit path is the only file written", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+
+		err := NewCoverageReporter().GenerateReport(entries, "reports/app-coverage.html")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"reports/app-coverage.html"}, writtenFiles(t))
+		assert.NoFileExists(t, JSCoverageHTML)
+	})
+
+	t.Run("configured path is used without an explicit one", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+
+		reporter := NewCoverageReporter()
+		reporter.SetOutputPath("custom.html")
+		require.NoError(t, reporter.GenerateReport(entries, ""))
+
+		assert.Equal(t, []string{"custom.html"}, writtenFiles(t))
+	})
+
+	t.Run("default path", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+
+		require.NoError(t, NewCoverageReporter().GenerateReport(entries, ""))
+
+		assert.Equal(t, []string{JSCoverageHTML}, writtenFiles(t))
+		report, err := os.ReadFile(JSCoverageHTML)
+		require.NoError(t, err)
+		assert.Contains(t, string(report), "JavaScript Coverage Report")
+	})
+}
+
+func TestGenerateCoverageIndex(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	err := NewCoverageReporter().GenerateCoverageIndex("", []CoverageReportLink{
+		{Label: "JavaScript coverage", Path: JSCoverageHTML},
+		{Label: "CSS coverage", Path: "coverage/css/index.html"},
+		{Label: "Go coverage <unit>", Path: GoCoverageHTML},
+	})
+	require.NoError(t, err)
+
+	index, err := os.ReadFile(CoverageIndexHTML)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(index), `<a href="js-coverage.html">JavaScript coverage</a>`)
+	assert.Contains(t, string(index), `<a href="css/index.html">CSS coverage</a>`)
+	assert.Contains(t, string(index), `<a href="go-cover.html">Go coverage &lt;unit&gt;</a>`)
+	assert.Equal(t, 3, strings.Count(string(index), "<li>"))
+
+	t.Run("links are relative to the index", func(t *testing.T) {
+		err := NewCoverageReporter().GenerateCoverageIndex("site/reports.html", []CoverageReportLink{
+			{Path: "coverage/js-coverage.html"},
+			{Label: "Remote", Path: "https://ci.example.com/coverage.html"},
+		})
+		require.NoError(t, err)
+
+		index, err := os.ReadFile("site/reports.html")
+		require.NoError(t, err)
+		assert.Contains(t, string(index), `<a href="../coverage/js-coverage.html">js-coverage.html</a>`)
+		assert.Contains(t, string(index), `<a href="https://ci.example.com/coverage.html">Remote</a>`)
+	})
+
+	t.Run("no reports", func(t *testing.T) {
+		assert.Error(t, NewCoverageReporter().GenerateCoverageIndex("", nil))
+	})
+}
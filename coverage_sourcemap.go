@@ -0,0 +1,86 @@
+package rodwer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// sourceMappingURLPrefix starts the comment that links a script to its source map
+const sourceMappingURLPrefix = "//# sourceMappingURL="
+
+// sourceMap holds the parts of a source map the report uses
+type sourceMap struct {
+	Version int      `json:"version"`
+	Sources []string `json:"sources"`
+}
+
+// inlineSourceMap decodes the base64 data URL source map embedded at the end of source, if any
+func inlineSourceMap(source string) (*sourceMap, bool) {
+	idx := strings.LastIndex(source, sourceMappingURLPrefix)
+	if idx < 0 {
+		return nil, false
+	}
+
+	url := strings.TrimSpace(source[idx+len(sourceMappingURLPrefix):])
+	if !strings.HasPrefix(url, "data:") {
+		return nil, false
+	}
+
+	_, data, found := strings.Cut(url, ";base64,")
+	if !found {
+		return nil, false
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, false
+	}
+
+	var sm sourceMap
+	if err := json.Unmarshal(raw, &sm); err != nil || len(sm.Sources) == 0 {
+		return nil, false
+	}
+
+	return &sm, true
+}
+
+// sourceLanguage returns the highlight language for a file name
+func sourceLanguage(name string) string {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".ts", ".tsx", ".mts", ".cts":
+		return "typescript"
+	default:
+		return "javascript"
+	}
+}
+
+// fileDisplayName labels an entry with its original source when the script carries a source map.
+// Bundles of several originals are shown as their first source plus the number of others.
+func fileDisplayName(entry FileEntry) string {
+	if sm, ok := inlineSourceMap(entry.Source); ok {
+		if len(sm.Sources) == 1 {
+			return sm.Sources[0]
+		}
+		return fmt.Sprintf("%s (+%d more)", sm.Sources[0], len(sm.Sources)-1)
+	}
+
+	if entry.URL == "" {
+		return fmt.Sprintf("Script %s", entry.ScriptID)
+	}
+	return entry.URL
+}
+
+// fileLanguage returns the highlight language of an entry, TypeScript when any original source is TypeScript
+func fileLanguage(entry FileEntry) string {
+	if sm, ok := inlineSourceMap(entry.Source); ok {
+		for _, source := range sm.Sources {
+			if lang := sourceLanguage(source); lang != "javascript" {
+				return lang
+			}
+		}
+	}
+	return "javascript"
+}
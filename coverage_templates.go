@@ -1,7 +1,6 @@
 package rodwer
 
 import (
-	"fmt"
 	"sort"
 	"strings"
 	"text/template"
@@ -182,7 +181,7 @@ const sourceLineTemplate = `{{range .}}
 <tr class="{{.LineClass}}">
     <td class="line-number px-4 py-1 text-right text-gray-500 select-none w-16">{{.LineNumber}}</td>
     <td class="px-4 py-1">
-        <pre class="whitespace-pre-wrap font-mono text-xs"><code class="language-javascript">{{.EscapedLine}}</code></pre>
+        <pre class="whitespace-pre-wrap font-mono text-xs"><code class="language-{{.Language}}">{{.EscapedLine}}</code></pre>
     </td>
 </tr>{{end}}`
 
@@ -224,6 +223,7 @@ type lineData struct {
 	LineNumber  int
 	LineClass   string
 	EscapedLine string
+	Language    string
 }
 
 // Template generation functions
@@ -305,13 +305,9 @@ func generateSummaryCards(metrics CoverageMetrics) string {
 func generateFileTable(entries []FileEntry) string {
 	var files []fileData
 	for _, entry := range entries {
-		fileName := entry.URL
-		if fileName == "" {
-			fileName = fmt.Sprintf("Script %s", entry.ScriptID)
-		}
 		files = append(files, fileData{
 			ScriptID:        string(entry.ScriptID),
-			FileName:        fileName,
+			FileName:        fileDisplayName(entry),
 			Metrics:         entry.Metrics,
 			StmtBadgeColor:  getCoverageBadgeColor(entry.Metrics.Statements.Pct),
 			FuncBadgeColor:  getCoverageBadgeColor(entry.Metrics.Functions.Pct),
@@ -328,13 +324,9 @@ func generateFileTable(entries []FileEntry) string {
 func generateFileDetails(entries []FileEntry) string {
 	var files []fileData
 	for _, entry := range entries {
-		fileName := entry.URL
-		if fileName == "" {
-			fileName = fmt.Sprintf("Script %s", entry.ScriptID)
-		}
 		files = append(files, fileData{
 			ScriptID:    string(entry.ScriptID),
-			FileName:    fileName,
+			FileName:    fileDisplayName(entry),
 			Metrics:     entry.Metrics,
 			SourceLines: generateSourceLines(entry),
 		})
@@ -357,6 +349,8 @@ func generateSourceLines(entry FileEntry) string {
 		}
 	}
 
+	language := fileLanguage(entry)
+
 	var lines []lineData
 	for lineNum, line := range entry.Lines {
 		lineStart := 0
@@ -386,6 +380,7 @@ func generateSourceLines(entry FileEntry) string {
 			LineNumber:  lineNum + 1,
 			LineClass:   lineClass,
 			EscapedLine: strings.Replace(strings.Replace(line, "<", "&lt;", -1), ">", "&gt;", -1),
+			Language:    language,
 		})
 	}
 
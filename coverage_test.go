@@ -0,0 +1,141 @@
+package rodwer
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURLFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		patterns []string
+		want     bool
+	}{
+		{"empty filter keeps everything", "https://cdn.example.com/vendor.js", nil, true},
+		{"star crosses path segments", "http://localhost:8080/static/js/app.js", []string{"http://localhost:*/app.js"}, true},
+		{"any pattern may match", "http://localhost/lib/app.js", []string{"*/vendor/*", "*/lib/*"}, true},
+		{"third party script dropped", "https://cdn.example.com/vendor.js", []string{"http://localhost*"}, false},
+		{"question mark matches one character", "http://localhost/app1.js", []string{"*/app?.js"}, true},
+		{"dots are literal", "http://localhost/appXjs", []string{"*/app.js"}, false},
+		{"inline scripts have no URL", "", []string{"*.js"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, compileURLFilter(tt.patterns).matches(tt.url))
+		})
+	}
+}
+
+func TestCollectCoverageEntries(t *testing.T) {
+	scripts := []*proto.ProfilerScriptCoverage{
+		{ScriptID: "1", URL: "http://localhost/app.js"},
+		{ScriptID: "2", URL: "https://cdn.example.com/vendor.js"},
+		{ScriptID: "3", URL: "http://localhost/empty.js"},
+	}
+
+	var fetched []proto.RuntimeScriptID
+	entries := collectCoverageEntries(scripts, compileURLFilter([]string{"http://localhost/*"}), func(id proto.RuntimeScriptID) (string, error) {
+		fetched = append(fetched, id)
+		if id == "3" {
+			return "", nil
+		}
+		return "console.log('app')", nil
+	})
+
+	assert.Equal(t, []proto.RuntimeScriptID{"1", "3"}, fetched, "Filtered out scripts are skipped before their source is fetched")
+	if assert.Len(t, entries, 1, "Scripts without source are skipped") {
+		assert.Equal(t, "http://localhost/app.js", entries[0].URL)
+	}
+}
+
+func TestAggregateCoverageMetrics(t *testing.T) {
+	metrics := []CoverageMetrics{
+		{
+			Statements: CoverageStat{Total: 100, Covered: 50},
+			Functions:  CoverageStat{Total: 3, Covered: 2},
+			Lines:      CoverageStat{Total: 10, Covered: 5},
+		},
+		{
+			Statements: CoverageStat{Total: 100, Covered: 100},
+			Functions:  CoverageStat{Total: 1, Covered: 1},
+			Lines:      CoverageStat{Total: 10, Covered: 10},
+		},
+	}
+
+	total := aggregateCoverageMetrics(metrics)
+	assert.Equal(t, CoverageStat{Total: 200, Covered: 150, Pct: 75}, total.Statements)
+	assert.Equal(t, CoverageStat{Total: 4, Covered: 3, Pct: 75}, total.Functions)
+	assert.Equal(t, CoverageStat{Total: 20, Covered: 15, Pct: 75}, total.Lines)
+
+	assert.Equal(t, CoverageMetrics{}, aggregateCoverageMetrics(nil), "No scripts means zero coverage, not NaN")
+}
+
+func TestCoverageEntrySummarize(t *testing.T) {
+	source := "function used() {\n  return 1;\n}\nfunction unused() {\n  return 2;\n}\nused();\n"
+	unusedStart := strings.Index(source, "function unused")
+	unusedEnd := strings.Index(source, "used();")
+
+	entry := CoverageEntry{
+		URL:    "http://localhost/app.js",
+		Source: source,
+		Ranges: []CoverageRange{
+			{Start: 0, End: len(source), Count: 1},
+			{Start: unusedStart, End: unusedEnd, Count: 0}, // nested, never ran
+		},
+	}
+
+	summary := entry.Summarize()
+	assert.Equal(t, CoverageSummary{LineCount: 7, CoveredLineCount: 4, CoveredPercent: calculatePct(4, 7)}, summary)
+	assert.Equal(t, 7, entry.LineCount, "Summarize should fill in the entry's fields")
+	assert.Equal(t, 4, entry.CoveredLineCount)
+	assert.InDelta(t, 57.14, entry.CoveredPercent, 0.01)
+
+	empty := CoverageEntry{}
+	assert.Equal(t, CoverageSummary{}, empty.Summarize())
+
+	// V8 offsets count UTF-16 code units, "é" is one unit but two bytes and "😀" two units but four bytes
+	multiByte := "const a = 'é😀';\nfunction unused() {}\nused();\n"
+	units := func(s string) int { return len(utf16.Encode([]rune(s))) }
+	unusedStart = units(multiByte[:strings.Index(multiByte, "function unused")])
+	unusedEnd = units(multiByte[:strings.Index(multiByte, "used();")])
+
+	entry = CoverageEntry{
+		Source: multiByte,
+		Ranges: []CoverageRange{
+			{Start: 0, End: units(multiByte), Count: 1},
+			{Start: unusedStart, End: unusedEnd, Count: 0},
+		},
+	}
+	assert.Equal(t, CoverageSummary{LineCount: 3, CoveredLineCount: 2, CoveredPercent: calculatePct(2, 3)}, entry.Summarize(),
+		"Only the unused function's line is uncovered")
+}
+
+func TestWaitForStableCount(t *testing.T) {
+	t.Run("waits for consecutive unchanged polls", func(t *testing.T) {
+		// The count grows on the first polls, then stays put
+		var polls atomic.Int64
+		count := func() int64 {
+			n := polls.Add(1)
+			if n > 4 {
+				return 4
+			}
+			return n
+		}
+
+		assert.True(t, waitForStableCount(count, 3, time.Millisecond, time.Second))
+		assert.Equal(t, int64(7), polls.Load(), "4 changing polls then 3 stable ones")
+	})
+
+	t.Run("times out while the count keeps changing", func(t *testing.T) {
+		var polls atomic.Int64
+		assert.False(t, waitForStableCount(func() int64 { return polls.Add(1) }, 3, time.Millisecond, 20*time.Millisecond))
+	})
+}
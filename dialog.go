@@ -0,0 +1,99 @@
+package rodwer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// DialogHandler handles a JavaScript dialog opened by the page
+type DialogHandler func(d *Dialog)
+
+// Dialog is an alert, confirm, prompt or beforeunload dialog blocking the page until it is accepted or dismissed
+type Dialog struct {
+	page  *rod.Page
+	event *proto.PageJavascriptDialogOpening
+
+	mu      sync.Mutex
+	handled bool
+}
+
+// Type returns the dialog type: "alert", "confirm", "prompt" or "beforeunload"
+func (d *Dialog) Type() string {
+	return string(d.event.Type)
+}
+
+// Message returns the text shown in the dialog
+func (d *Dialog) Message() string {
+	return d.event.Message
+}
+
+// DefaultValue returns the default text of a prompt dialog
+func (d *Dialog) DefaultValue() string {
+	return d.event.DefaultPrompt
+}
+
+// Accept closes the dialog with OK, promptText is the answer to a prompt dialog
+func (d *Dialog) Accept(promptText ...string) error {
+	req := proto.PageHandleJavaScriptDialog{Accept: true}
+	if len(promptText) > 0 {
+		req.PromptText = promptText[0]
+	}
+
+	return d.resolve("accept", req)
+}
+
+// Dismiss closes the dialog with Cancel
+func (d *Dialog) Dismiss() error {
+	return d.resolve("dismiss", proto.PageHandleJavaScriptDialog{Accept: false})
+}
+
+// resolve sends req unless the dialog was already handled
+func (d *Dialog) resolve(name string, req proto.PageHandleJavaScriptDialog) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.handled {
+		return fmt.Errorf("%s dialog is already handled", d.Type())
+	}
+	d.handled = true
+
+	if err := req.Call(d.page); err != nil {
+		return fmt.Errorf("failed to %s %s dialog: %w", name, d.Type(), err)
+	}
+
+	return nil
+}
+
+// OnDialog passes every dialog the page opens to handler until cancel is called.
+// Dialogs the handler leaves open are dismissed once it returns, so they never block the page.
+// Register one handler at a time, a dialog can only be answered once.
+func (p *Page) OnDialog(handler DialogHandler) (cancel func()) {
+	ctx, cancel := context.WithCancel(p.ctx)
+
+	wait := p.page.Context(ctx).EachEvent(func(e *proto.PageJavascriptDialogOpening) {
+		go p.handleDialog(e, handler)
+	})
+	p.listen(wait)
+
+	return cancel
+}
+
+// handleDialog runs handler for the dialog and dismisses it if the handler didn't answer
+func (p *Page) handleDialog(e *proto.PageJavascriptDialogOpening, handler DialogHandler) {
+	dialog := &Dialog{page: p.page.Context(p.ctx), event: e}
+	if handler != nil {
+		handler(dialog)
+	}
+
+	dialog.mu.Lock()
+	handled := dialog.handled
+	dialog.mu.Unlock()
+
+	if !handled {
+		_ = dialog.Dismiss()
+	}
+}
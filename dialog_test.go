@@ -0,0 +1,86 @@
+package rodwer
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// DialogTestSuite covers JavaScript dialog handling
+type DialogTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *DialogTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *DialogTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *DialogTestSuite) TestOnDialog() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.SetContent(`<html><body>dialogs</body></html>`))
+
+	type seen struct{ typ, message, defaultValue string }
+	var mu sync.Mutex
+	var dialogs []seen
+
+	cancel := page.OnDialog(func(d *Dialog) {
+		mu.Lock()
+		dialogs = append(dialogs, seen{d.Type(), d.Message(), d.DefaultValue()})
+		mu.Unlock()
+
+		switch d.Type() {
+		case "alert":
+			s.NoError(d.Accept())
+			s.Error(d.Dismiss(), "A dialog is answered only once")
+		case "prompt":
+			s.NoError(d.Accept("rodwer"))
+		}
+		// confirm is left open and dismissed automatically
+	})
+	defer cancel()
+
+	s.Run("alert", func() {
+		_, err := page.Evaluate(`() => alert('Saved')`)
+		s.Require().NoError(err)
+	})
+
+	s.Run("confirm is dismissed when the handler doesn't answer", func() {
+		res, err := page.Evaluate(`() => confirm('Delete?')`)
+		s.Require().NoError(err)
+		s.Equal(false, res)
+	})
+
+	s.Run("prompt", func() {
+		res, err := page.Evaluate(`() => prompt('Name?', 'guest')`)
+		s.Require().NoError(err)
+		s.Equal("rodwer", res)
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	s.Equal([]seen{
+		{"alert", "Saved", ""},
+		{"confirm", "Delete?", ""},
+		{"prompt", "Name?", "guest"},
+	}, dialogs)
+}
+
+// Run the dialog test suite
+func TestDialogSuite(t *testing.T) {
+	suite.Run(t, new(DialogTestSuite))
+}
@@ -0,0 +1,56 @@
+package rodwer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// SetDefaultDownloadBehavior saves downloads from every page of the browser into dir
+func (b *Browser) SetDefaultDownloadBehavior(dir string) error {
+	// Chrome only accepts absolute download paths
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve download directory: %w", err)
+	}
+
+	if err := os.MkdirAll(absDir, 0755); err != nil {
+		return fmt.Errorf("failed to create download directory: %w", err)
+	}
+
+	return b.setDownloadBehavior(proto.BrowserSetDownloadBehavior{
+		Behavior:     proto.BrowserSetDownloadBehaviorBehaviorAllow,
+		DownloadPath: absDir,
+	})
+}
+
+// DisableDownloads denies downloads from every page of the browser
+func (b *Browser) DisableDownloads() error {
+	return b.setDownloadBehavior(proto.BrowserSetDownloadBehavior{
+		Behavior: proto.BrowserSetDownloadBehaviorBehaviorDeny,
+	})
+}
+
+// setDownloadBehavior applies the download behavior to the default browser context
+func (b *Browser) setDownloadBehavior(req proto.BrowserSetDownloadBehavior) error {
+	b.mu.RLock()
+	closed := b.closed
+	browser := b.browser
+	b.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("browser is closed")
+	}
+
+	if err := req.Call(browser); err != nil {
+		return fmt.Errorf("failed to set download behavior: %w", err)
+	}
+
+	b.mu.Lock()
+	b.downloadBehavior = &req
+	b.mu.Unlock()
+
+	return nil
+}
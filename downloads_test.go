@@ -0,0 +1,107 @@
+package rodwer
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// DownloadTestSuite covers browser-wide download behavior
+type DownloadTestSuite struct {
+	suite.Suite
+	testServer *TestServer
+	cleanupFn  func()
+}
+
+func (s *DownloadTestSuite) SetupSuite() {
+	testServer, cleanup := NewTestServer()
+	s.testServer = testServer
+	s.cleanupFn = cleanup
+
+	testServer.AddRoute("/downloads", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body>
+			<a id="first" href="/files/first.txt">first</a>
+			<a id="second" href="/files/second.txt">second</a>
+		</body></html>`))
+	})
+	testServer.AddRoute("/files/", func(w http.ResponseWriter, r *http.Request) {
+		name := filepath.Base(r.URL.Path)
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", "attachment; filename="+name)
+		w.Write([]byte("downloaded " + name))
+	})
+}
+
+func (s *DownloadTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+// clickDownload opens a new page on the download list and clicks the given link
+func (s *DownloadTestSuite) clickDownload(browser *Browser, selector string) {
+	page, err := browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.Navigate(s.testServer.URL + "/downloads")
+	s.Require().NoError(err)
+
+	link, err := page.Element(selector)
+	s.Require().NoError(err)
+	s.Require().NoError(link.Click())
+
+	// Give the browser time to start writing the file before the page closes
+	time.Sleep(500 * time.Millisecond)
+}
+
+func (s *DownloadTestSuite) TestSetDefaultDownloadBehavior() {
+	browser, err := NewBrowser(BrowserOptions{Headless: true})
+	s.Require().NoError(err)
+	defer browser.Close()
+
+	dir := s.T().TempDir()
+	err = browser.SetDefaultDownloadBehavior(dir)
+	s.Require().NoError(err)
+
+	// The page is created after the behavior was set and inherits it
+	s.clickDownload(browser, "#first")
+
+	s.Eventually(func() bool {
+		data, err := os.ReadFile(filepath.Join(dir, "first.txt"))
+		return err == nil && string(data) == "downloaded first.txt"
+	}, 5*time.Second, 100*time.Millisecond, "Download should land in the configured directory")
+}
+
+func (s *DownloadTestSuite) TestDisableDownloads() {
+	browser, err := NewBrowser(BrowserOptions{Headless: true})
+	s.Require().NoError(err)
+	defer browser.Close()
+
+	dir := s.T().TempDir()
+	s.Require().NoError(browser.SetDefaultDownloadBehavior(dir))
+	s.Require().NoError(browser.DisableDownloads())
+
+	s.clickDownload(browser, "#second")
+
+	s.Never(func() bool {
+		_, err := os.Stat(filepath.Join(dir, "second.txt"))
+		return err == nil
+	}, time.Second, 100*time.Millisecond, "Denied downloads should not be written")
+
+	s.Run("closed browser", func() {
+		s.Require().NoError(browser.Close())
+		s.Error(browser.DisableDownloads())
+		s.Error(browser.SetDefaultDownloadBehavior(dir))
+	})
+}
+
+// Run the download test suite
+func TestDownloadSuite(t *testing.T) {
+	suite.Run(t, new(DownloadTestSuite))
+}
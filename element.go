@@ -0,0 +1,920 @@
+package rodwer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/input"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// WaitForSiblingCount waits until the element's parent has exactly expected children matching selector.
+// The element itself is counted when it matches selector.
+func (e Element) WaitForSiblingCount(selector string, expected int, timeout time.Duration) error {
+	if e.element == nil {
+		return fmt.Errorf("element is nil")
+	}
+
+	last := -1
+	err := e.page.waitUntil(timeout, func(page *rod.Page) bool {
+		res, err := e.element.Context(page.GetContext()).Eval(`(s) => {
+			const parent = this.parentElement;
+			if (!parent) return 0;
+			return Array.from(parent.children).filter(c => c.matches(s)).length;
+		}`, selector)
+		if err != nil {
+			return false
+		}
+		last = res.Value.Int()
+		return last == expected
+	})
+	if err != nil {
+		return fmt.Errorf("timeout waiting for %d siblings matching %s (last count %d): %w", expected, selector, last, err)
+	}
+
+	return nil
+}
+
+// Files returns the names of the files attached to a file input, in selection order
+func (e Element) Files() ([]string, error) {
+	if e.element == nil {
+		return nil, fmt.Errorf("element is nil")
+	}
+
+	res, err := e.element.Eval(`() => {
+		if (!(this instanceof HTMLInputElement) || this.type !== 'file') return null;
+		return Array.from(this.files).map(f => f.name);
+	}`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read files: %w", err)
+	}
+
+	if res.Value.Nil() {
+		return nil, fmt.Errorf("element is not a file input")
+	}
+
+	names := make([]string, 0, len(res.Value.Arr()))
+	for _, name := range res.Value.Arr() {
+		names = append(names, name.Str())
+	}
+
+	return names, nil
+}
+
+// SetInputFiles attaches the files at paths to a file input, replacing its current selection.
+// Every path must be an existing file.
+func (e Element) SetInputFiles(paths ...string) error {
+	if err := e.checkUsable(); err != nil {
+		return err
+	}
+
+	files := make([]string, 0, len(paths))
+	for _, path := range paths {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return fmt.Errorf("failed to resolve %s: %w", path, err)
+		}
+
+		info, err := os.Stat(abs)
+		if err != nil {
+			return fmt.Errorf("file not found: %s", path)
+		}
+		if info.IsDir() {
+			return fmt.Errorf("not a file: %s", path)
+		}
+
+		files = append(files, abs)
+	}
+
+	res, err := e.element.Eval(`() => this instanceof HTMLInputElement && this.type === 'file'`)
+	if err != nil {
+		return fmt.Errorf("failed to inspect element: %w", err)
+	}
+
+	if !res.Value.Bool() {
+		return fmt.Errorf("element is not a file input")
+	}
+
+	if err := e.element.SetFiles(files); err != nil {
+		return fmt.Errorf("failed to set input files: %w", err)
+	}
+
+	return nil
+}
+
+// ScrollIntoViewOptions configures where ScrollIntoView aligns the element
+type ScrollIntoViewOptions struct {
+	Block  string // vertical alignment: "start" (default), "center", "end" or "nearest"
+	Inline string // horizontal alignment: "start", "center", "end" or "nearest" (default)
+}
+
+// ScrollIntoView scrolls the element into the visible area of the page.
+// Without options the page only scrolls when the element is not already visible.
+func (e Element) ScrollIntoView(opts ...ScrollIntoViewOptions) error {
+	if e.element == nil {
+		return fmt.Errorf("element is nil")
+	}
+
+	if len(opts) == 0 {
+		if err := e.element.ScrollIntoView(); err != nil {
+			return fmt.Errorf("failed to scroll element into view: %w", err)
+		}
+		return nil
+	}
+
+	block, inline := opts[0].Block, opts[0].Inline
+	if block == "" {
+		block = "start"
+	}
+	if inline == "" {
+		inline = "nearest"
+	}
+
+	for _, alignment := range []string{block, inline} {
+		switch alignment {
+		case "start", "center", "end", "nearest":
+		default:
+			return fmt.Errorf("invalid scroll alignment %q, expected start, center, end or nearest", alignment)
+		}
+	}
+
+	_, err := e.element.Eval(`(block, inline) => this.scrollIntoView({ block, inline, behavior: 'instant' })`, block, inline)
+	if err != nil {
+		return fmt.Errorf("failed to scroll element into view: %w", err)
+	}
+
+	return nil
+}
+
+// Hover scrolls the element into view and moves the mouse over it
+func (e Element) Hover() error {
+	if err := e.ScrollIntoView(); err != nil {
+		return err
+	}
+
+	if err := e.element.Hover(); err != nil {
+		return fmt.Errorf("failed to hover element: %w", err)
+	}
+
+	return nil
+}
+
+// ElementPosition is an offset in CSS pixels from the center of an element
+type ElementPosition struct {
+	X float64
+	Y float64
+}
+
+// HoverOptions configures HoverWithOptions
+type HoverOptions struct {
+	Position  *ElementPosition // defaults to the element center
+	Modifiers []KeyModifier    // keys held while the mouse moves
+}
+
+// HoverWithOptions scrolls the element into view and moves the mouse to a point of it while holding modifiers
+func (e Element) HoverWithOptions(opts HoverOptions) error {
+	if err := e.ScrollIntoView(); err != nil {
+		return err
+	}
+
+	point, err := e.viewportPoint(opts.Position)
+	if err != nil {
+		return err
+	}
+
+	modifiers := make([]string, len(opts.Modifiers))
+	for i, m := range opts.Modifiers {
+		modifiers[i] = string(m)
+	}
+
+	return e.withModifiers(modifiers, func() error {
+		if err := e.element.Page().Mouse.MoveTo(point); err != nil {
+			return fmt.Errorf("failed to hover element: %w", err)
+		}
+		return nil
+	})
+}
+
+// viewportPoint returns the viewport coordinates of position, the element center when nil
+func (e Element) viewportPoint(position *ElementPosition) (proto.Point, error) {
+	box, err := e.viewportBox()
+	if err != nil {
+		return proto.Point{}, err
+	}
+
+	point := proto.Point{X: box.X + box.Width/2, Y: box.Y + box.Height/2}
+	if position != nil {
+		point.X += position.X
+		point.Y += position.Y
+	}
+
+	return point, nil
+}
+
+// DragOptions configures DragTo
+type DragOptions struct {
+	SourceOffset *ElementPosition // where the drag starts, defaults to the source center
+	TargetOffset *ElementPosition // where the drop happens, defaults to the target center
+}
+
+// dragAndDrop dispatches the HTML5 drag and drop events from this to target sharing one DataTransfer
+const dragAndDrop = `(target, from, to) => {
+	const dataTransfer = new DataTransfer();
+	const fire = (el, type, point) => el.dispatchEvent(new DragEvent(type, {
+		bubbles: true,
+		cancelable: true,
+		composed: true,
+		clientX: point.x,
+		clientY: point.y,
+		dataTransfer,
+	}));
+
+	if (!fire(this, 'dragstart', from)) {
+		fire(this, 'dragend', from);
+		return false;
+	}
+	fire(this, 'drag', from);
+	fire(target, 'dragenter', to);
+	// A listener accepts the drop by cancelling dragover
+	const accepted = !fire(target, 'dragover', to);
+	if (accepted) {
+		fire(target, 'drop', to);
+	} else {
+		fire(target, 'dragleave', to);
+	}
+	fire(this, 'dragend', to);
+	return accepted;
+}`
+
+// DragTo drags the element onto target by dispatching dragstart, drag, dragenter, dragover, drop and dragend.
+// An error is returned when dragstart is cancelled or no dragover listener accepts the drop.
+func (e Element) DragTo(target Element, opts ...DragOptions) error {
+	if err := e.checkUsable(); err != nil {
+		return err
+	}
+
+	if err := target.checkUsable(); err != nil {
+		return fmt.Errorf("invalid drop target: %w", err)
+	}
+
+	var options DragOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+
+	if err := e.ScrollIntoView(); err != nil {
+		return err
+	}
+
+	from, err := e.viewportPoint(options.SourceOffset)
+	if err != nil {
+		return err
+	}
+
+	to, err := target.viewportPoint(options.TargetOffset)
+	if err != nil {
+		return fmt.Errorf("invalid drop target: %w", err)
+	}
+
+	res, err := e.element.Eval(dragAndDrop, target.element.Object, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to drag element: %w", err)
+	}
+
+	if !res.Value.Bool() {
+		return fmt.Errorf("drop was not accepted by the target")
+	}
+
+	return nil
+}
+
+// InnerText returns the text as rendered: hidden descendants are skipped and whitespace is collapsed per CSS
+func (e Element) InnerText() (string, error) {
+	if e.element == nil {
+		return "", fmt.Errorf("element is nil")
+	}
+
+	res, err := e.element.Eval(`() => this.innerText`)
+	if err != nil {
+		return "", fmt.Errorf("failed to get inner text: %w", err)
+	}
+
+	return res.Value.Str(), nil
+}
+
+// TextContent returns the raw text of all descendants, including hidden ones, with whitespace untouched
+func (e Element) TextContent() (string, error) {
+	if e.element == nil {
+		return "", fmt.Errorf("element is nil")
+	}
+
+	res, err := e.element.Eval(`() => this.textContent`)
+	if err != nil {
+		return "", fmt.Errorf("failed to get text content: %w", err)
+	}
+
+	return res.Value.Str(), nil
+}
+
+// InnerHTML returns the serialized HTML of the element's children
+func (e Element) InnerHTML() (string, error) {
+	if e.element == nil {
+		return "", fmt.Errorf("element is nil")
+	}
+
+	res, err := e.element.Eval(`() => this.innerHTML`)
+	if err != nil {
+		return "", fmt.Errorf("failed to get inner HTML: %w", err)
+	}
+
+	return res.Value.Str(), nil
+}
+
+// SetInnerHTML replaces the element's children with html. Scripts in html are not executed.
+func (e Element) SetInnerHTML(html string) error {
+	if e.element == nil {
+		return fmt.Errorf("element is nil")
+	}
+
+	if _, err := e.element.Eval(`(html) => { this.innerHTML = html; }`, html); err != nil {
+		return fmt.Errorf("failed to set inner HTML: %w", err)
+	}
+
+	return nil
+}
+
+// OuterHTML returns the serialized HTML of the element including its own tag
+func (e Element) OuterHTML() (string, error) {
+	if e.element == nil {
+		return "", fmt.Errorf("element is nil")
+	}
+
+	res, err := e.element.Eval(`() => this.outerHTML`)
+	if err != nil {
+		return "", fmt.Errorf("failed to get outer HTML: %w", err)
+	}
+
+	return res.Value.Str(), nil
+}
+
+// GetAttribute returns the attribute value and whether the attribute is present
+func (e Element) GetAttribute(name string) (string, bool, error) {
+	if e.element == nil {
+		return "", false, fmt.Errorf("element is nil")
+	}
+
+	value, err := e.element.Attribute(name)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get attribute %s: %w", name, err)
+	}
+
+	if value == nil {
+		return "", false, nil
+	}
+
+	return *value, true, nil
+}
+
+// SetAttribute sets the attribute to value, adding it when missing
+func (e Element) SetAttribute(name, value string) error {
+	if e.element == nil {
+		return fmt.Errorf("element is nil")
+	}
+
+	_, err := e.element.Eval(`(name, value) => this.setAttribute(name, value)`, name, value)
+	if err != nil {
+		return fmt.Errorf("failed to set attribute %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// RemoveAttribute removes the attribute, doing nothing when it is missing
+func (e Element) RemoveAttribute(name string) error {
+	if e.element == nil {
+		return fmt.Errorf("element is nil")
+	}
+
+	_, err := e.element.Eval(`(name) => this.removeAttribute(name)`, name)
+	if err != nil {
+		return fmt.Errorf("failed to remove attribute %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// HasAttribute reports whether the element has the attribute, even with an empty value
+func (e Element) HasAttribute(name string) (bool, error) {
+	if e.element == nil {
+		return false, fmt.Errorf("element is nil")
+	}
+
+	res, err := e.element.Eval(`(name) => this.hasAttribute(name)`, name)
+	if err != nil {
+		return false, fmt.Errorf("failed to check attribute %s: %w", name, err)
+	}
+
+	return res.Value.Bool(), nil
+}
+
+// Attributes returns every attribute of the element by name, an empty map when it has none
+func (e Element) Attributes() (map[string]string, error) {
+	if e.element == nil {
+		return nil, fmt.Errorf("element is nil")
+	}
+
+	res, err := e.element.Eval(`() => Object.fromEntries(Array.from(this.attributes, a => [a.name, a.value]))`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attributes: %w", err)
+	}
+
+	attributes := make(map[string]string, len(res.Value.Map()))
+	for name, value := range res.Value.Map() {
+		attributes[name] = value.Str()
+	}
+
+	return attributes, nil
+}
+
+// IsVisible reports whether the element is rendered and not hidden by CSS
+func (e Element) IsVisible() (bool, error) {
+	if e.element == nil {
+		return false, fmt.Errorf("element is nil")
+	}
+
+	visible, err := e.element.Visible()
+	if err != nil {
+		return false, fmt.Errorf("failed to check visibility: %w", err)
+	}
+
+	return visible, nil
+}
+
+// IsEnabled reports whether the element's disabled property is unset, elements without one count as enabled
+func (e Element) IsEnabled() (bool, error) {
+	if e.element == nil {
+		return false, fmt.Errorf("element is nil")
+	}
+
+	res, err := e.element.Eval(`() => !this.disabled`)
+	if err != nil {
+		return false, fmt.Errorf("failed to check enabled state: %w", err)
+	}
+
+	return res.Value.Bool(), nil
+}
+
+// IsChecked reports whether a checkbox or radio input is checked
+func (e Element) IsChecked() (bool, error) {
+	if e.element == nil {
+		return false, fmt.Errorf("element is nil")
+	}
+
+	res, err := e.element.Eval(`() => {
+		const checkable = this.tagName === 'INPUT' && (this.type === 'checkbox' || this.type === 'radio');
+		return { checkable, checked: checkable && this.checked };
+	}`)
+	if err != nil {
+		return false, fmt.Errorf("failed to check checked state: %w", err)
+	}
+
+	if !res.Value.Get("checkable").Bool() {
+		return false, fmt.Errorf("element is not a checkbox or radio input")
+	}
+
+	return res.Value.Get("checked").Bool(), nil
+}
+
+// Release frees the element's remote object in the browser. The element and its copies can't be used afterwards.
+func (e Element) Release() error {
+	if e.element == nil {
+		return fmt.Errorf("element is nil")
+	}
+
+	if e.page != nil {
+		defer e.page.untrackObject(e.element.Object.ObjectID)
+	}
+
+	if err := e.element.Release(); err != nil {
+		return fmt.Errorf("failed to release element: %w", err)
+	}
+
+	return nil
+}
+
+// IsEditable reports whether the user can type into the element: an enabled, non read-only input,
+// textarea or select, or a contenteditable element
+func (e Element) IsEditable() (bool, error) {
+	if e.element == nil {
+		return false, fmt.Errorf("element is nil")
+	}
+
+	res, err := e.element.Eval(`() => {
+		if (['INPUT', 'TEXTAREA', 'SELECT'].includes(this.tagName)) {
+			return !this.disabled && !this.readOnly;
+		}
+		return this.isContentEditable;
+	}`)
+	if err != nil {
+		return false, fmt.Errorf("failed to check editable state: %w", err)
+	}
+
+	return res.Value.Bool(), nil
+}
+
+// ComputedRole returns the element's ARIA role as computed by the browser's accessibility tree, e.g. "button" or "navigation"
+func (e Element) ComputedRole() (string, error) {
+	if e.element == nil {
+		return "", fmt.Errorf("element is nil")
+	}
+
+	node, err := e.element.Describe(0, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to describe element: %w", err)
+	}
+
+	tree, err := proto.AccessibilityGetPartialAXTree{BackendNodeID: node.BackendNodeID}.Call(e.element)
+	if err != nil {
+		return "", fmt.Errorf("failed to get accessibility node: %w", err)
+	}
+
+	// The partial tree includes relatives, pick the element's own node
+	for _, ax := range tree.Nodes {
+		if ax.BackendDOMNodeID == node.BackendNodeID && ax.Role != nil {
+			return ax.Role.Value.Str(), nil
+		}
+	}
+
+	return "", fmt.Errorf("element has no accessibility node")
+}
+
+// Check checks a checkbox or radio input, clicking it only when it isn't checked yet
+func (e Element) Check() error {
+	return e.setChecked(true)
+}
+
+// Uncheck unchecks a checkbox input, clicking it only when it is checked
+func (e Element) Uncheck() error {
+	return e.setChecked(false)
+}
+
+// setChecked clicks the element when its checked state differs from checked and verifies the result
+func (e Element) setChecked(checked bool) error {
+	current, err := e.IsChecked()
+	if err != nil {
+		return err
+	}
+
+	if current == checked {
+		return nil
+	}
+
+	if err := e.Click(); err != nil {
+		return err
+	}
+
+	// Clicking a checked radio or a prevented click leaves the state unchanged
+	current, err = e.IsChecked()
+	if err != nil {
+		return err
+	}
+	if current != checked {
+		return fmt.Errorf("element checked state is still %t after click", current)
+	}
+
+	return nil
+}
+
+// ClickWithModifiers clicks the element while holding modifier keys: "Control", "Shift", "Alt" or "Meta"
+func (e Element) ClickWithModifiers(modifiers ...string) error {
+	if e.element == nil {
+		return fmt.Errorf("element is nil")
+	}
+
+	return e.withModifiers(modifiers, e.Click)
+}
+
+// ClickOptions configures DoubleClickWithOptions
+type ClickOptions struct {
+	Modifiers []KeyModifier // keys held during the click
+}
+
+// DoubleClick scrolls the element into view and double-clicks it, firing a single dblclick event
+func (e Element) DoubleClick() error {
+	return e.DoubleClickWithOptions(ClickOptions{})
+}
+
+// DoubleClickWithOptions double-clicks the element while holding opts.Modifiers
+func (e Element) DoubleClickWithOptions(opts ClickOptions) error {
+	if err := e.checkUsable(); err != nil {
+		return err
+	}
+
+	modifiers := make([]string, len(opts.Modifiers))
+	for i, m := range opts.Modifiers {
+		modifiers[i] = string(m)
+	}
+
+	return e.withModifiers(modifiers, func() error {
+		return e.ClickButton(proto.InputMouseButtonLeft, 2)
+	})
+}
+
+// RightClick scrolls the element into view and right-clicks it, opening its context menu
+func (e Element) RightClick() error {
+	return e.ClickButton(proto.InputMouseButtonRight, 1)
+}
+
+// characterCenter returns the viewport center of the character at offset in the element's text, null when out of range
+const characterCenter = `(offset) => {
+	const walker = document.createTreeWalker(this, NodeFilter.SHOW_TEXT);
+	for (let node = walker.nextNode(); node; node = walker.nextNode()) {
+		if (offset < node.length) {
+			const range = document.createRange();
+			range.setStart(node, offset);
+			range.setEnd(node, offset + 1);
+			const rect = range.getBoundingClientRect();
+			return { x: rect.left + rect.width / 2, y: rect.top + rect.height / 2 };
+		}
+		offset -= node.length;
+	}
+	return null;
+}`
+
+// SelectWord double-clicks the character at offset in the element's text, selecting the word around it
+// the way a user would, and returns the selected text without surrounding whitespace
+func (e Element) SelectWord(offset int) (string, error) {
+	if err := e.checkUsable(); err != nil {
+		return "", err
+	}
+
+	if offset < 0 {
+		return "", fmt.Errorf("offset must not be negative, got %d", offset)
+	}
+
+	if err := e.ScrollIntoView(); err != nil {
+		return "", err
+	}
+
+	res, err := e.element.Eval(characterCenter, offset)
+	if err != nil {
+		return "", fmt.Errorf("failed to locate character %d: %w", offset, err)
+	}
+
+	if res.Value.Nil() {
+		return "", fmt.Errorf("offset %d is beyond the element's text", offset)
+	}
+
+	mouse := e.element.Page().Mouse
+	point := proto.Point{X: res.Value.Get("x").Num(), Y: res.Value.Get("y").Num()}
+	if err := mouse.MoveTo(point); err != nil {
+		return "", fmt.Errorf("failed to move to character %d: %w", offset, err)
+	}
+
+	if err := mouse.Click(proto.InputMouseButtonLeft, 2); err != nil {
+		return "", fmt.Errorf("failed to double-click character %d: %w", offset, err)
+	}
+
+	selection, err := e.element.Eval(`() => window.getSelection().toString()`)
+	if err != nil {
+		return "", fmt.Errorf("failed to read selection: %w", err)
+	}
+
+	return strings.TrimSpace(selection.Value.Str()), nil
+}
+
+// checkUsable fails when the element is nil or its page was closed
+func (e Element) checkUsable() error {
+	if e.element == nil {
+		return fmt.Errorf("element is nil")
+	}
+
+	if e.page != nil {
+		e.page.mu.RLock()
+		closed := e.page.closed
+		e.page.mu.RUnlock()
+
+		if closed {
+			return ErrPageClosed
+		}
+	}
+
+	return nil
+}
+
+// withModifiers runs action while the named modifier keys are held down
+func (e Element) withModifiers(modifiers []string, action func() error) error {
+	keys := make([]input.Key, 0, len(modifiers))
+	for _, name := range modifiers {
+		key, ok := shortcutModifiers[name]
+		if !ok {
+			return fmt.Errorf("unknown modifier %q", name)
+		}
+		keys = append(keys, key)
+	}
+
+	keyboard := e.element.Page().Keyboard
+	defer func() {
+		for i := len(keys) - 1; i >= 0; i-- {
+			_ = keyboard.Release(keys[i])
+		}
+	}()
+
+	for i, key := range keys {
+		if err := keyboard.Press(key); err != nil {
+			return fmt.Errorf("failed to press %s: %w", modifiers[i], err)
+		}
+	}
+
+	return action()
+}
+
+// SelectOption selects the options of a <select> element matching values and dispatches input and change.
+// Each value matches an option's value first, then its visible text. A multi-select ends up with
+// exactly the given options selected.
+func (e Element) SelectOption(values ...string) error {
+	if e.element == nil {
+		return fmt.Errorf("element is nil")
+	}
+
+	if len(values) == 0 {
+		return fmt.Errorf("no option values given")
+	}
+
+	res, err := e.element.Eval(`(values) => {
+		if (this.tagName !== 'SELECT') return { select: false };
+		const opts = Array.from(this.options);
+		const matches = values.map(v => {
+			if (opts.some(o => o.hasAttribute('value') && o.value === v)) return 'value';
+			if (opts.some(o => o.text.trim() === v)) return 'text';
+			return '';
+		});
+		return { select: true, multiple: this.multiple, matches };
+	}`, values)
+	if err != nil {
+		return fmt.Errorf("failed to find options: %w", err)
+	}
+
+	if !res.Value.Get("select").Bool() {
+		return fmt.Errorf("element is not a select")
+	}
+
+	if len(values) > 1 && !res.Value.Get("multiple").Bool() {
+		return fmt.Errorf("cannot select %d options in a single select", len(values))
+	}
+
+	var byValue, byText []string
+	for i, match := range res.Value.Get("matches").Arr() {
+		switch match.Str() {
+		case "value":
+			byValue = append(byValue, `option[value="`+cssStringEscaper.Replace(values[i])+`"]`)
+		case "text":
+			byText = append(byText, `^\s*`+regexp.QuoteMeta(values[i])+`\s*$`)
+		default:
+			return fmt.Errorf("no option matches %q", values[i])
+		}
+	}
+
+	if res.Value.Get("multiple").Bool() {
+		if _, err := e.element.Eval(`() => { for (const o of this.options) o.selected = false }`); err != nil {
+			return fmt.Errorf("failed to reset selection: %w", err)
+		}
+	}
+
+	if len(byValue) > 0 {
+		if err := e.element.Select(byValue, true, rod.SelectorTypeCSSSector); err != nil {
+			return fmt.Errorf("failed to select options: %w", err)
+		}
+	}
+
+	if len(byText) > 0 {
+		if err := e.element.Select(byText, true, rod.SelectorTypeRegex); err != nil {
+			return fmt.Errorf("failed to select options: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// cssStringEscaper escapes a value for a double quoted CSS string
+var cssStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\a `)
+
+// highlightOutline is the outline drawn around elements by Highlight
+const highlightOutline = "3px solid rgba(255, 0, 255, 0.8)"
+
+// Highlight outlines the element for duration so it can be spotted in headful mode, then restores its style
+func (e Element) Highlight(duration time.Duration) error {
+	if e.element == nil {
+		return fmt.Errorf("element is nil")
+	}
+
+	res, err := e.element.Eval(`(outline) => {
+		const previous = this.style.outline;
+		this.style.outline = outline;
+		return previous;
+	}`, highlightOutline)
+	if err != nil {
+		return fmt.Errorf("failed to highlight element: %w", err)
+	}
+
+	select {
+	case <-time.After(duration):
+	case <-e.page.ctx.Done():
+	}
+
+	_, err = e.element.Eval(`(previous) => { this.style.outline = previous; }`, res.Value.Str())
+	if err != nil {
+		return fmt.Errorf("failed to clear element highlight: %w", err)
+	}
+
+	return nil
+}
+
+// Box describes an element's position and size in CSS pixels
+type Box struct {
+	X      float64
+	Y      float64
+	Width  float64
+	Height float64
+}
+
+// Contains reports whether the point x, y lies inside the box, including its top and left edges
+func (b Box) Contains(x, y float64) bool {
+	return x >= b.X && x < b.X+b.Width && y >= b.Y && y < b.Y+b.Height
+}
+
+// BoundingBox returns the element's box relative to the viewport
+func (e Element) BoundingBox() (Box, error) {
+	if e.element == nil {
+		return Box{}, fmt.Errorf("element is nil")
+	}
+
+	return e.viewportBox()
+}
+
+// BoundingBoxPage returns the element's box relative to the document, accounting for scroll
+func (e Element) BoundingBoxPage() (Box, error) {
+	if e.element == nil {
+		return Box{}, fmt.Errorf("element is nil")
+	}
+
+	box, err := e.viewportBox()
+	if err != nil {
+		return Box{}, err
+	}
+
+	res, err := e.element.Eval(`() => ({ x: window.scrollX, y: window.scrollY })`)
+	if err != nil {
+		return Box{}, fmt.Errorf("failed to get scroll offset: %w", err)
+	}
+
+	box.X += res.Value.Get("x").Num()
+	box.Y += res.Value.Get("y").Num()
+
+	return box, nil
+}
+
+// viewportBox returns the element's bounding box relative to the viewport
+func (e Element) viewportBox() (Box, error) {
+	shape, err := e.element.Shape()
+	if err != nil {
+		return Box{}, fmt.Errorf("failed to get element bounds: %w", err)
+	}
+
+	if len(shape.Quads) == 0 {
+		return Box{}, fmt.Errorf("element has no quads")
+	}
+
+	quad := shape.Quads[0]
+
+	// Calculate bounding box
+	minX, maxX := quad[0], quad[0]
+	minY, maxY := quad[1], quad[1]
+
+	for i := 0; i < len(quad); i += 2 {
+		if quad[i] < minX {
+			minX = quad[i]
+		}
+		if quad[i] > maxX {
+			maxX = quad[i]
+		}
+		if quad[i+1] < minY {
+			minY = quad[i+1]
+		}
+		if quad[i+1] > maxY {
+			maxY = quad[i+1]
+		}
+	}
+
+	return Box{
+		X:      minX,
+		Y:      minY,
+		Width:  maxX - minX,
+		Height: maxY - minY,
+	}, nil
+}
@@ -0,0 +1,1205 @@
+package rodwer
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/stretchr/testify/suite"
+)
+
+// ElementTestSuite covers Element helpers beyond the core interaction API
+type ElementTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *ElementTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *ElementTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *ElementTestSuite) TestWaitForSiblingCount() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	html := `
+	<html>
+	<body>
+		<ul id="list">
+			<li id="first" class="item">Item 1</li>
+		</ul>
+		<script>
+			function addItem(n) {
+				var li = document.createElement('li');
+				li.className = 'item';
+				li.textContent = 'Item ' + n;
+				document.getElementById('list').appendChild(li);
+			}
+			setTimeout(function() { addItem(2); }, 200);
+			setTimeout(function() { addItem(3); }, 400);
+		</script>
+	</body>
+	</html>`
+
+	err = page.Navigate("data:text/html," + html)
+	s.Require().NoError(err)
+
+	first, err := page.Element("#first")
+	s.Require().NoError(err)
+
+	s.Run("count reached", func() {
+		err := first.WaitForSiblingCount("li.item", 3, 3*time.Second)
+		s.Require().NoError(err)
+
+		items, err := page.Elements("li.item")
+		s.Require().NoError(err)
+		s.Len(items, 3)
+	})
+
+	s.Run("timeout when count never matches", func() {
+		err := first.WaitForSiblingCount("li.item", 10, 200*time.Millisecond)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "timeout")
+		s.Contains(err.Error(), "li.item")
+	})
+}
+
+func (s *ElementTestSuite) TestFiles() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	html := `<html><body><input id="upload" type="file" multiple><input id="name" type="text"></body></html>`
+	err = page.Navigate("data:text/html," + html)
+	s.Require().NoError(err)
+
+	dir := s.T().TempDir()
+	first := filepath.Join(dir, "first.txt")
+	second := filepath.Join(dir, "second.csv")
+	s.Require().NoError(os.WriteFile(first, []byte("one"), 0600))
+	s.Require().NoError(os.WriteFile(second, []byte("two"), 0600))
+
+	upload, err := page.Element("#upload")
+	s.Require().NoError(err)
+
+	files, err := upload.Files()
+	s.Require().NoError(err)
+	s.Empty(files, "No files should be attached initially")
+
+	s.Require().NoError(upload.element.SetFiles([]string{first, second}))
+
+	files, err = upload.Files()
+	s.Require().NoError(err)
+	s.Equal([]string{"first.txt", "second.csv"}, files)
+
+	s.Run("non file input", func() {
+		name, err := page.Element("#name")
+		s.Require().NoError(err)
+
+		_, err = name.Files()
+		s.Error(err)
+		s.Contains(err.Error(), "not a file input")
+	})
+}
+
+func (s *ElementTestSuite) TestSelectWord() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<p id="text">The quick <b>brown</b> fox-trot jumps</p>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	text, err := page.Element("#text")
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		offset int
+		word   string
+	}{
+		{0, "The"},
+		{6, "quick"},
+		{11, "brown"}, // inside the <b> text node
+		{26, "jumps"},
+	} {
+		word, err := text.SelectWord(tc.offset)
+		s.Require().NoError(err)
+		s.Equal(tc.word, word, "offset %d", tc.offset)
+	}
+
+	s.Run("word boundary at punctuation", func() {
+		word, err := text.SelectWord(16)
+		s.Require().NoError(err)
+		s.Equal("fox", word, "The hyphen ends the word")
+	})
+
+	s.Run("offset out of range", func() {
+		_, err := text.SelectWord(100)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "beyond")
+	})
+}
+
+func (s *ElementTestSuite) TestRightClick() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<div id="row" onclick="this.dataset.clicked = 'true'">Row</div>
+		<script>
+			document.getElementById('row').addEventListener('contextmenu', (event) => {
+				event.preventDefault();
+				event.target.dataset.menu = 'button-' + event.button;
+			});
+		</script>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	row, err := page.Element("#row")
+	s.Require().NoError(err)
+
+	s.Require().NoError(row.RightClick())
+
+	menu, _, err := row.GetAttribute("data-menu")
+	s.Require().NoError(err)
+	s.Equal("button-2", menu, "contextmenu listener should fire for the right button")
+
+	_, found, err := row.GetAttribute("data-clicked")
+	s.Require().NoError(err)
+	s.False(found, "A right-click is not a click")
+
+	s.Run("invalid count", func() {
+		s.Error(row.ClickButton(proto.InputMouseButtonLeft, 0))
+	})
+}
+
+func (s *ElementTestSuite) TestElementsByCSSProperty() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><head><style>
+		:root { --surface: rgb(255, 255, 255); }
+		[data-theme="dark"] { --surface: rgb(0, 0, 0); }
+		.card { background-color: var(--surface); }
+	</style></head><body>
+		<div id="light" class="card">Light</div>
+		<section data-theme="dark">
+			<div id="dark-1" class="card">Dark</div>
+			<div id="dark-2" class="card">Dark</div>
+		</section>
+		<div id="dark-3" class="card" data-theme="dark">Dark</div>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	ids := func(elements []Element) []string {
+		var result []string
+		for _, el := range elements {
+			id, _, err := el.GetAttribute("id")
+			s.Require().NoError(err)
+			result = append(result, id)
+		}
+		return result
+	}
+
+	dark, err := page.ElementsByCSSProperty("background-color", "rgb(0, 0, 0)")
+	s.Require().NoError(err)
+	s.Equal([]string{"dark-1", "dark-2", "dark-3"}, ids(dark))
+
+	s.Run("custom property", func() {
+		elements, err := page.ElementsByCSSProperty("--surface", "rgb(255, 255, 255)")
+		s.Require().NoError(err)
+		s.Contains(ids(elements), "light")
+		s.NotContains(ids(elements), "dark-1")
+	})
+
+	s.Run("no match", func() {
+		elements, err := page.ElementsByCSSProperty("background-color", "rgb(1, 2, 3)")
+		s.Require().NoError(err)
+		s.Empty(elements)
+	})
+}
+
+func (s *ElementTestSuite) TestSetInputFiles() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body><input id="upload" type="file"><input id="name" type="text"></body></html>`)
+	s.Require().NoError(err)
+
+	path := filepath.Join(s.T().TempDir(), "report.pdf")
+	s.Require().NoError(os.WriteFile(path, []byte("%PDF-1.4"), 0600))
+
+	upload, err := page.Element("#upload")
+	s.Require().NoError(err)
+
+	s.Require().NoError(upload.SetInputFiles(path))
+
+	count, err := page.Evaluate(`() => document.getElementById('upload').files.length === 1`)
+	s.Require().NoError(err)
+	s.Equal(true, count)
+
+	name, err := page.Evaluate(`() => document.getElementById('upload').files[0].name`)
+	s.Require().NoError(err)
+	s.Equal("report.pdf", name)
+
+	s.Run("missing file", func() {
+		missing := filepath.Join(s.T().TempDir(), "missing.pdf")
+		err := upload.SetInputFiles(missing)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "file not found")
+		s.Contains(err.Error(), "missing.pdf")
+	})
+
+	s.Run("non file input", func() {
+		name, err := page.Element("#name")
+		s.Require().NoError(err)
+
+		err = name.SetInputFiles(path)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "not a file input")
+	})
+}
+
+func (s *ElementTestSuite) TestBoundingBox() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body style="margin: 0">
+		<div style="height: 20px"></div>
+		<div id="square" style="margin-left: 30px; width: 100px; height: 100px; background: red"></div>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	square, err := page.Element("#square")
+	s.Require().NoError(err)
+
+	box, err := square.BoundingBox()
+	s.Require().NoError(err)
+	s.InDelta(30, box.X, 1)
+	s.InDelta(20, box.Y, 1)
+	s.InDelta(100, box.Width, 1)
+	s.InDelta(100, box.Height, 1)
+
+	s.True(box.Contains(80, 70), "Center should be inside")
+	s.True(box.Contains(box.X, box.Y), "Top left corner should be inside")
+	s.False(box.Contains(box.X+box.Width, box.Y), "Right edge should be outside")
+	s.False(box.Contains(10, 70))
+
+	s.Run("nil element", func() {
+		_, err := Element{}.BoundingBox()
+		s.Error(err)
+	})
+}
+
+func (s *ElementTestSuite) TestBoundingBoxPage() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	html := `
+	<html>
+	<body style="margin: 0">
+		<div style="height: 1500px"></div>
+		<div id="target" style="width: 100px; height: 50px; background: blue"></div>
+		<div style="height: 1500px"></div>
+	</body>
+	</html>`
+
+	err = page.Navigate("data:text/html," + html)
+	s.Require().NoError(err)
+
+	_, err = page.page.Eval(`() => window.scrollTo(0, 1000)`)
+	s.Require().NoError(err)
+
+	target, err := page.Element("#target")
+	s.Require().NoError(err)
+
+	viewport, err := target.viewportBox()
+	s.Require().NoError(err)
+
+	box, err := target.BoundingBoxPage()
+	s.Require().NoError(err)
+
+	s.InDelta(1500, box.Y, 1, "Page-relative Y should ignore scrolling")
+	s.InDelta(1000, box.Y-viewport.Y, 1, "Offsets should differ by the scroll position")
+	s.InDelta(100, box.Width, 1)
+	s.InDelta(50, box.Height, 1)
+}
+
+func (s *ElementTestSuite) TestScrollIntoView() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<div style="height: 5000px"></div>
+		<button id="far">Far away</button>
+		<div style="height: 5000px"></div>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	button, err := page.Element("#far")
+	s.Require().NoError(err)
+
+	viewportHeight, err := page.Evaluate(`() => window.innerHeight`)
+	s.Require().NoError(err)
+
+	box, err := button.viewportBox()
+	s.Require().NoError(err)
+	s.Greater(box.Y, viewportHeight.(float64), "Button should start below the fold")
+
+	s.Require().NoError(button.ScrollIntoView())
+
+	box, err = button.viewportBox()
+	s.Require().NoError(err)
+	s.GreaterOrEqual(box.Y, 0.0)
+	s.LessOrEqual(box.Y+box.Height, viewportHeight.(float64), "Button should be inside the viewport")
+
+	s.Run("block alignment", func() {
+		s.Require().NoError(button.ScrollIntoView(ScrollIntoViewOptions{Block: "center"}))
+
+		box, err := button.viewportBox()
+		s.Require().NoError(err)
+		s.InDelta(viewportHeight.(float64)/2, box.Y+box.Height/2, 1, "Button should be vertically centered")
+
+		s.Require().NoError(button.ScrollIntoView(ScrollIntoViewOptions{Block: "start"}))
+
+		box, err = button.viewportBox()
+		s.Require().NoError(err)
+		s.InDelta(0, box.Y, 1, "Button should be at the top of the viewport")
+	})
+
+	s.Run("invalid alignment", func() {
+		s.Error(button.ScrollIntoView(ScrollIntoViewOptions{Block: "middle"}))
+	})
+
+	s.Run("nil element", func() {
+		s.Error(Element{}.ScrollIntoView())
+	})
+}
+
+func (s *ElementTestSuite) TestHover() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	// The trigger sits below the fold so Hover has to scroll to it
+	err = page.SetContent(`<html>
+	<head><style>
+		#tooltip { display: none; }
+		#trigger:hover + #tooltip { display: block; }
+		#trigger:hover { background-color: rgb(0, 128, 0); }
+	</style></head>
+	<body>
+		<div style="height: 3000px"></div>
+		<button id="trigger">Help</button>
+		<div id="tooltip">Tooltip text</div>
+	</body>
+	</html>`)
+	s.Require().NoError(err)
+
+	tooltip, err := page.Element("#tooltip")
+	s.Require().NoError(err)
+
+	visible, err := tooltip.element.Visible()
+	s.Require().NoError(err)
+	s.False(visible, "Tooltip should start hidden")
+
+	trigger, err := page.Element("#trigger")
+	s.Require().NoError(err)
+	s.Require().NoError(trigger.Hover())
+
+	visible, err = tooltip.element.Visible()
+	s.Require().NoError(err)
+	s.True(visible, "Tooltip should show while hovering the trigger")
+
+	background, err := page.Evaluate(`() => getComputedStyle(document.getElementById('trigger')).backgroundColor`)
+	s.Require().NoError(err)
+	s.Equal("rgb(0, 128, 0)", background, "The :hover style should apply")
+
+	s.Run("nil element", func() {
+		s.Error(Element{}.Hover())
+	})
+}
+
+func (s *ElementTestSuite) TestHoverWithOptions() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html>
+	<head><style>
+		#bar { display: flex; width: 200px; height: 40px; }
+		#bar div { flex: 1; }
+		#bar div:hover { background-color: rgb(255, 0, 0); }
+	</style></head>
+	<body>
+		<div id="bar"><div id="left"></div><div id="right"></div></div>
+		<script>
+			document.getElementById('bar').addEventListener('mousemove', e => { document.body.dataset.shift = e.shiftKey; });
+		</script>
+	</body>
+	</html>`)
+	s.Require().NoError(err)
+
+	bar, err := page.Element("#bar")
+	s.Require().NoError(err)
+
+	background := func(id string) interface{} {
+		res, err := page.Evaluate(`(id) => getComputedStyle(document.getElementById(id)).backgroundColor`, id)
+		s.Require().NoError(err)
+		return res
+	}
+
+	s.Require().NoError(bar.HoverWithOptions(HoverOptions{
+		Position:  &ElementPosition{X: 50},
+		Modifiers: []KeyModifier{KeyModifierShift},
+	}))
+
+	s.Equal("rgb(255, 0, 0)", background("right"), "The offset should land on the right half")
+	s.Equal("rgba(0, 0, 0, 0)", background("left"))
+
+	shift, err := page.Evaluate(`() => document.body.dataset.shift`)
+	s.Require().NoError(err)
+	s.Equal("true", shift, "Shift should be held while moving")
+
+	s.Run("left of center", func() {
+		s.Require().NoError(bar.HoverWithOptions(HoverOptions{Position: &ElementPosition{X: -50}}))
+		s.Equal("rgb(255, 0, 0)", background("left"))
+	})
+
+	s.Run("unknown modifier", func() {
+		s.Error(bar.HoverWithOptions(HoverOptions{Modifiers: []KeyModifier{"Hyper"}}))
+	})
+}
+
+func (s *ElementTestSuite) TestDragTo() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<ul id="list">
+			<li id="a" draggable="true">A</li>
+			<li id="b" draggable="true">B</li>
+			<li id="c" draggable="true">C</li>
+		</ul>
+		<div id="locked">Locked</div>
+		<script>
+			const list = document.getElementById('list');
+			list.addEventListener('dragstart', (e) => e.dataTransfer.setData('text/plain', e.target.id));
+			list.addEventListener('dragover', (e) => e.preventDefault());
+			list.addEventListener('drop', (e) => {
+				e.preventDefault();
+				const dragged = document.getElementById(e.dataTransfer.getData('text/plain'));
+				const target = e.target.closest('li');
+				const rect = target.getBoundingClientRect();
+				// Dropping on the lower half places the item after the target
+				target.parentNode.insertBefore(dragged, e.clientY > rect.top + rect.height / 2 ? target.nextSibling : target);
+			});
+		</script>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	order := func() string {
+		res, err := page.Evaluate(`() => Array.from(document.querySelectorAll('#list li')).map(li => li.id).join('')`)
+		s.Require().NoError(err)
+		return res.(string)
+	}
+
+	item := func(id string) Element {
+		el, err := page.Element("#" + id)
+		s.Require().NoError(err)
+		return el
+	}
+
+	s.Require().NoError(item("c").DragTo(item("a")))
+	s.Equal("cab", order(), "Dropping on the center of A places C before it")
+
+	s.Run("target offset", func() {
+		s.Require().NoError(item("c").DragTo(item("b"), DragOptions{TargetOffset: &ElementPosition{Y: 5}}))
+		s.Equal("abc", order(), "Dropping on the lower half of B places C after it")
+	})
+
+	s.Run("drop not accepted", func() {
+		err := item("a").DragTo(item("locked"))
+		s.Require().Error(err)
+		s.Contains(err.Error(), "not accepted")
+		s.Equal("abc", order())
+	})
+}
+
+func (s *ElementTestSuite) TestTextVariants() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body><div id="text">
+		Hello
+		<span style="display:none">hidden</span>
+		<b>world</b>   !
+	</div></body></html>`)
+	s.Require().NoError(err)
+
+	el, err := page.Element("#text")
+	s.Require().NoError(err)
+
+	s.Run("inner text is rendered and collapsed", func() {
+		text, err := el.InnerText()
+		s.Require().NoError(err)
+		s.Equal("Hello world !", text)
+	})
+
+	s.Run("text content is raw", func() {
+		text, err := el.TextContent()
+		s.Require().NoError(err)
+		s.Contains(text, "hidden")
+		s.Contains(text, "\n")
+		s.Equal("Hello hidden world !", strings.Join(strings.Fields(text), " "))
+	})
+
+	s.Run("text trims on request", func() {
+		text, err := el.Text(TextOptions{TrimSpace: true})
+		s.Require().NoError(err)
+		s.Equal(strings.TrimSpace(text), text)
+		s.Equal("Hello world !", text)
+	})
+}
+
+func (s *ElementTestSuite) TestHTML() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body><div id="editor" class="ql-editor"><p>Hello <strong>world</strong></p></div></body></html>`)
+	s.Require().NoError(err)
+
+	editor, err := page.Element("#editor")
+	s.Require().NoError(err)
+
+	inner, err := editor.InnerHTML()
+	s.Require().NoError(err)
+	s.Equal("<p>Hello <strong>world</strong></p>", inner)
+
+	outer, err := editor.OuterHTML()
+	s.Require().NoError(err)
+	s.Equal(`<div id="editor" class="ql-editor"><p>Hello <strong>world</strong></p></div>`, outer)
+
+	s.Run("set inner HTML", func() {
+		s.Require().NoError(editor.SetInnerHTML("<ul><li>one</li><li>two</li></ul>"))
+
+		items, err := page.Elements("#editor li")
+		s.Require().NoError(err)
+		s.Len(items, 2)
+
+		inner, err := editor.InnerHTML()
+		s.Require().NoError(err)
+		s.Equal("<ul><li>one</li><li>two</li></ul>", inner)
+	})
+
+	s.Run("nil element", func() {
+		_, err := Element{}.InnerHTML()
+		s.Error(err)
+		_, err = Element{}.OuterHTML()
+		s.Error(err)
+		s.Error(Element{}.SetInnerHTML("<p></p>"))
+	})
+}
+
+func (s *ElementTestSuite) TestAttributes() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.Navigate(`data:text/html,<html><body><a id="link" href="/docs" data-empty="">Docs</a></body></html>`)
+	s.Require().NoError(err)
+
+	link, err := page.Element("a")
+	s.Require().NoError(err)
+
+	s.Run("present attribute", func() {
+		value, found, err := link.GetAttribute("href")
+		s.Require().NoError(err)
+		s.True(found)
+		s.Equal("/docs", value)
+	})
+
+	s.Run("empty attribute is still present", func() {
+		value, found, err := link.GetAttribute("data-empty")
+		s.Require().NoError(err)
+		s.True(found)
+		s.Empty(value)
+	})
+
+	s.Run("missing attribute", func() {
+		value, found, err := link.GetAttribute("data-x")
+		s.Require().NoError(err)
+		s.False(found)
+		s.Empty(value)
+	})
+
+	s.Run("set attribute", func() {
+		s.Require().NoError(link.SetAttribute("data-x", "1"))
+
+		value, found, err := link.GetAttribute("data-x")
+		s.Require().NoError(err)
+		s.True(found)
+		s.Equal("1", value)
+	})
+
+	s.Run("has and remove attribute", func() {
+		has, err := link.HasAttribute("data-empty")
+		s.Require().NoError(err)
+		s.True(has, "Empty attributes count as present")
+
+		s.Require().NoError(link.SetAttribute("aria-expanded", "true"))
+		has, err = link.HasAttribute("aria-expanded")
+		s.Require().NoError(err)
+		s.True(has)
+
+		s.Require().NoError(link.RemoveAttribute("aria-expanded"))
+		has, err = link.HasAttribute("aria-expanded")
+		s.Require().NoError(err)
+		s.False(has)
+
+		s.NoError(link.RemoveAttribute("aria-expanded"), "Removing a missing attribute is a no-op")
+	})
+
+	s.Run("all attributes", func() {
+		err := page.SetContent(`<html><body>
+			<div id="card" class="card" data-user-id="42" data-role="admin" data-active="">Card</div>
+			<span>Plain</span>
+		</body></html>`)
+		s.Require().NoError(err)
+
+		card, err := page.Element("#card")
+		s.Require().NoError(err)
+
+		attributes, err := card.Attributes()
+		s.Require().NoError(err)
+		s.Equal(map[string]string{
+			"id":           "card",
+			"class":        "card",
+			"data-user-id": "42",
+			"data-role":    "admin",
+			"data-active":  "",
+		}, attributes)
+
+		plain, err := page.Element("span")
+		s.Require().NoError(err)
+
+		attributes, err = plain.Attributes()
+		s.Require().NoError(err)
+		s.NotNil(attributes)
+		s.Empty(attributes)
+	})
+
+	s.Run("nil element", func() {
+		_, _, err := Element{}.GetAttribute("href")
+		s.Error(err)
+		s.Error(Element{}.SetAttribute("href", "/"))
+
+		_, err = Element{}.Attributes()
+		s.Error(err)
+		_, err = Element{}.HasAttribute("href")
+		s.Error(err)
+		s.Error(Element{}.RemoveAttribute("href"))
+	})
+}
+
+func (s *ElementTestSuite) TestStatePredicates() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><head><style>.collapsed { display: none } .invisible { visibility: hidden }</style></head><body>
+		<div id="hidden" style="display: none">Hidden</div>
+		<div id="shown">Shown</div>
+		<div id="toggled">Toggled</div>
+		<button id="disabled" disabled>Disabled</button>
+		<button id="enabled">Enabled</button>
+		<input id="checked" type="checkbox" checked>
+		<input id="radio" type="radio">
+		<input id="text" type="text">
+		<input id="readonly" type="text" readonly>
+		<textarea id="disabled-area" disabled></textarea>
+		<div id="editable" contenteditable="true">Edit me</div>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	element := func(selector string) Element {
+		el, err := page.Element(selector)
+		s.Require().NoError(err)
+		return el
+	}
+
+	s.Run("visibility", func() {
+		visible, err := element("#hidden").IsVisible()
+		s.Require().NoError(err)
+		s.False(visible)
+
+		visible, err = element("#shown").IsVisible()
+		s.Require().NoError(err)
+		s.True(visible)
+	})
+
+	s.Run("visibility follows class toggles", func() {
+		toggled := element("#toggled")
+		for _, class := range []string{"collapsed", "invisible"} {
+			_, err := page.Evaluate(`(c) => document.querySelector('#toggled').classList.add(c)`, class)
+			s.Require().NoError(err)
+
+			visible, err := toggled.IsVisible()
+			s.Require().NoError(err)
+			s.False(visible, "Hidden by .%s", class)
+
+			_, err = page.Evaluate(`(c) => document.querySelector('#toggled').classList.remove(c)`, class)
+			s.Require().NoError(err)
+
+			visible, err = toggled.IsVisible()
+			s.Require().NoError(err)
+			s.True(visible, "Shown again without .%s", class)
+		}
+	})
+
+	s.Run("enabled", func() {
+		enabled, err := element("#disabled").IsEnabled()
+		s.Require().NoError(err)
+		s.False(enabled)
+
+		enabled, err = element("#enabled").IsEnabled()
+		s.Require().NoError(err)
+		s.True(enabled)
+	})
+
+	s.Run("checked", func() {
+		checked, err := element("#checked").IsChecked()
+		s.Require().NoError(err)
+		s.True(checked)
+
+		checked, err = element("#radio").IsChecked()
+		s.Require().NoError(err)
+		s.False(checked)
+
+		_, err = element("#text").IsChecked()
+		s.Error(err, "Text inputs can't be checked")
+	})
+
+	s.Run("editable", func() {
+		for selector, want := range map[string]bool{
+			"#text":          true,
+			"#editable":      true,
+			"#readonly":      false,
+			"#disabled-area": false,
+			"#shown":         false,
+		} {
+			editable, err := element(selector).IsEditable()
+			s.Require().NoError(err)
+			s.Equal(want, editable, selector)
+		}
+	})
+
+	s.Run("nil element", func() {
+		_, err := Element{}.IsVisible()
+		s.Error(err)
+		_, err = Element{}.IsEnabled()
+		s.Error(err)
+		_, err = Element{}.IsChecked()
+		s.Error(err)
+		_, err = Element{}.IsEditable()
+		s.Error(err)
+	})
+}
+
+func (s *ElementTestSuite) TestComputedRole() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<nav id="nav"><a id="link" href="/docs">Docs</a></nav>
+		<button id="button">Save</button>
+		<div id="dialog" role="dialog">Are you sure?</div>
+		<input id="checkbox" type="checkbox">
+	</body></html>`)
+	s.Require().NoError(err)
+
+	for selector, want := range map[string]string{
+		"#button":   "button",
+		"#nav":      "navigation",
+		"#dialog":   "dialog",
+		"#link":     "link",
+		"#checkbox": "checkbox",
+	} {
+		s.Run(selector, func() {
+			el, err := page.Element(selector)
+			s.Require().NoError(err)
+
+			role, err := el.ComputedRole()
+			s.Require().NoError(err)
+			s.Equal(want, role)
+		})
+	}
+}
+
+func (s *ElementTestSuite) TestCheckAndUncheck() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<input id="box" type="checkbox">
+		<input id="radio" type="radio" name="r">
+		<input id="text" type="text">
+	</body></html>`)
+	s.Require().NoError(err)
+
+	element := func(selector string) Element {
+		el, err := page.Element(selector)
+		s.Require().NoError(err)
+		return el
+	}
+
+	isChecked := func(selector string) bool {
+		checked, err := element(selector).IsChecked()
+		s.Require().NoError(err)
+		return checked
+	}
+
+	box := element("#box")
+
+	s.Require().NoError(box.Check())
+	s.True(isChecked("#box"))
+
+	s.Require().NoError(box.Check(), "Checking twice is a no-op")
+	s.True(isChecked("#box"))
+
+	s.Require().NoError(box.Uncheck())
+	s.False(isChecked("#box"))
+
+	s.Run("radio can be checked but not unchecked", func() {
+		radio := element("#radio")
+		s.Require().NoError(radio.Check())
+		s.True(isChecked("#radio"))
+		s.Error(radio.Uncheck())
+	})
+
+	s.Run("non checkable element", func() {
+		s.Error(element("#text").Check())
+		s.Error(element("#text").Uncheck())
+	})
+}
+
+func (s *ElementTestSuite) TestClickWithModifiers() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<button id="btn" onclick="this.dataset.keys = [event.ctrlKey && 'ctrl', event.shiftKey && 'shift', event.altKey && 'alt', event.metaKey && 'meta'].filter(Boolean).join('+') || 'none'">Click</button>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	button, err := page.Element("#btn")
+	s.Require().NoError(err)
+
+	keys := func() string {
+		value, _, err := button.GetAttribute("data-keys")
+		s.Require().NoError(err)
+		return value
+	}
+
+	s.Require().NoError(button.ClickWithModifiers("Control"))
+	s.Equal("ctrl", keys())
+
+	s.Run("several modifiers", func() {
+		s.Require().NoError(button.ClickWithModifiers("Control", "Shift"))
+		s.Equal("ctrl+shift", keys())
+	})
+
+	s.Run("modifiers are released", func() {
+		s.Require().NoError(button.Click())
+		s.Equal("none", keys())
+	})
+
+	s.Run("unknown modifier", func() {
+		err := button.ClickWithModifiers("Hyper")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "Hyper")
+	})
+}
+
+func (s *ElementTestSuite) TestDoubleClick() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<div id="cell" data-clicks="0" data-dblclicks="0"
+			onclick="this.dataset.clicks++"
+			ondblclick="this.dataset.dblclicks++; this.dataset.shift = event.shiftKey">Edit me</div>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	cell, err := page.Element("#cell")
+	s.Require().NoError(err)
+
+	attr := func(name string) string {
+		value, _, err := cell.GetAttribute(name)
+		s.Require().NoError(err)
+		return value
+	}
+
+	s.Require().NoError(cell.DoubleClick())
+	s.Equal("1", attr("data-dblclicks"), "dblclick handler should fire once")
+	s.Equal("1", attr("data-clicks"), "a double-click is not two single clicks")
+	s.Equal("false", attr("data-shift"))
+
+	s.Run("with modifiers", func() {
+		s.Require().NoError(cell.DoubleClickWithOptions(ClickOptions{Modifiers: []KeyModifier{KeyModifierShift}}))
+		s.Equal("2", attr("data-dblclicks"))
+		s.Equal("true", attr("data-shift"))
+	})
+
+	s.Run("nil element", func() {
+		s.Error(Element{}.DoubleClick())
+	})
+
+	s.Run("closed page", func() {
+		closedPage, err := s.browser.NewPage()
+		s.Require().NoError(err)
+		s.Require().NoError(closedPage.SetContent(`<html><body><div id="cell">Edit me</div></body></html>`))
+
+		stale, err := closedPage.Element("#cell")
+		s.Require().NoError(err)
+		s.Require().NoError(closedPage.Close())
+
+		s.True(errors.Is(stale.DoubleClick(), ErrPageClosed))
+	})
+}
+
+func (s *ElementTestSuite) TestSelectOption() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<select id="single" onchange="this.dataset.changed = this.value">
+			<option value="a">Option A</option>
+			<option value="b">Option B</option>
+			<option value="c">Option C</option>
+		</select>
+		<select id="multi" multiple>
+			<option value="red" selected>Red</option>
+			<option value="green">Green</option>
+			<option value="blue">Blue</option>
+		</select>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	single, err := page.Element("#single")
+	s.Require().NoError(err)
+
+	s.Run("select by visible text", func() {
+		s.Require().NoError(single.SelectOption("Option B"))
+
+		res, err := page.Evaluate(`() => document.querySelector('#single').value`)
+		s.Require().NoError(err)
+		s.Equal("b", res)
+
+		changed, ok, err := single.GetAttribute("data-changed")
+		s.Require().NoError(err)
+		s.True(ok, "change event was dispatched")
+		s.Equal("b", changed)
+	})
+
+	s.Run("select by value", func() {
+		s.Require().NoError(single.SelectOption("c"))
+
+		res, err := page.Evaluate(`() => document.querySelector('#single').value`)
+		s.Require().NoError(err)
+		s.Equal("c", res)
+	})
+
+	s.Run("unknown value", func() {
+		err := single.SelectOption("Option Z")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "Option Z")
+	})
+
+	s.Run("several values need a multi-select", func() {
+		s.Error(single.SelectOption("a", "b"))
+	})
+
+	s.Run("multi-select", func() {
+		multi, err := page.Element("#multi")
+		s.Require().NoError(err)
+		s.Require().NoError(multi.SelectOption("green", "Blue"))
+
+		res, err := page.Evaluate(`() => Array.from(document.querySelector('#multi').selectedOptions, o => o.value).join(',')`)
+		s.Require().NoError(err)
+		s.Equal("green,blue", res)
+	})
+}
+
+func (s *ElementTestSuite) TestTypeAppends() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<input id="input" value="abc">
+		<textarea id="area">abc</textarea>
+		<div id="editable" contenteditable="true">abc</div>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	// Park the caret at the start, where typing would prepend
+	_, err = page.Evaluate(`() => {
+		for (const id of ['input', 'area']) {
+			const el = document.getElementById(id);
+			el.focus();
+			el.setSelectionRange(0, 0);
+		}
+	}`)
+	s.Require().NoError(err)
+
+	for _, selector := range []string{"#input", "#area"} {
+		s.Run(selector, func() {
+			el, err := page.Element(selector)
+			s.Require().NoError(err)
+			s.Require().NoError(el.Type("def"))
+
+			value, err := el.Value()
+			s.Require().NoError(err)
+			s.Equal("abcdef", value)
+		})
+	}
+
+	s.Run("contenteditable", func() {
+		el, err := page.Element("#editable")
+		s.Require().NoError(err)
+		s.Require().NoError(el.Type("def"))
+
+		text, err := el.TextContent()
+		s.Require().NoError(err)
+		s.Equal("abcdef", text)
+	})
+}
+
+func (s *ElementTestSuite) TestClearVerifies() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<input id="plain" value="plain text">
+		<input id="controlled" value="stale text">
+		<input id="locked" value="locked">
+		<script>
+			// Like a controlled or masked input: keyboard edits are rejected, state follows input events
+			const controlled = document.getElementById('controlled');
+			window.state = controlled.value;
+			controlled.addEventListener('beforeinput', e => e.preventDefault());
+			controlled.addEventListener('input', () => { window.state = controlled.value; });
+
+			// Restores its value on every change, nothing can clear it
+			const locked = document.getElementById('locked');
+			locked.addEventListener('beforeinput', e => e.preventDefault());
+			locked.addEventListener('input', () => { locked.value = 'locked'; });
+		</script>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	value := func(selector string) string {
+		el, err := page.Element(selector)
+		s.Require().NoError(err)
+		v, err := el.Value()
+		s.Require().NoError(err)
+		return v
+	}
+
+	s.Run("plain input", func() {
+		el, err := page.Element("#plain")
+		s.Require().NoError(err)
+		s.Require().NoError(el.Clear())
+		s.Empty(value("#plain"))
+	})
+
+	s.Run("controlled input is cleared with the native setter", func() {
+		el, err := page.Element("#controlled")
+		s.Require().NoError(err)
+		s.Require().NoError(el.Clear())
+		s.Empty(value("#controlled"))
+
+		state, err := page.Evaluate(`() => window.state`)
+		s.Require().NoError(err)
+		s.Equal("", state, "The component should see the cleared value")
+	})
+
+	s.Run("input that keeps its value reports an error", func() {
+		el, err := page.Element("#locked")
+		s.Require().NoError(err)
+
+		err = el.Clear()
+		s.Require().Error(err)
+		s.Contains(err.Error(), `"locked"`)
+	})
+}
+
+func (s *ElementTestSuite) TestHighlight() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.Navigate(`data:text/html,<html><body><button id="target" style="outline: 1px dotted blue">Target</button></body></html>`)
+	s.Require().NoError(err)
+
+	target, err := page.Element("#target")
+	s.Require().NoError(err)
+
+	outline := func() string {
+		res, err := target.element.Eval(`() => this.style.outline`)
+		s.Require().NoError(err)
+		return res.Value.Str()
+	}
+	original := outline()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- target.Highlight(300 * time.Millisecond)
+	}()
+
+	s.Eventually(func() bool {
+		return outline() != original
+	}, time.Second, 20*time.Millisecond, "Element should be outlined while highlighted")
+
+	s.Require().NoError(<-done)
+	s.Equal(original, outline(), "Highlight should restore the original outline")
+}
+
+// Run the element test suite
+func TestElementSuite(t *testing.T) {
+	suite.Run(t, new(ElementTestSuite))
+}
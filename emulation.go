@@ -0,0 +1,154 @@
+package rodwer
+
+import (
+	"fmt"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// SetFontFamilies overrides the page's generic font families.
+// Keys are "standard", "fixed", "serif", "sansSerif", "cursive", "fantasy" and "math", values are font family names.
+func (p *Page) SetFontFamilies(fonts map[string]string) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	families := &proto.PageFontFamilies{}
+	for generic, family := range fonts {
+		switch generic {
+		case "standard":
+			families.Standard = family
+		case "fixed":
+			families.Fixed = family
+		case "serif":
+			families.Serif = family
+		case "sansSerif":
+			families.SansSerif = family
+		case "cursive":
+			families.Cursive = family
+		case "fantasy":
+			families.Fantasy = family
+		case "math":
+			families.Math = family
+		default:
+			return fmt.Errorf("unknown generic font family: %s", generic)
+		}
+	}
+
+	if err := (proto.PageSetFontFamilies{FontFamilies: families}).Call(p.page); err != nil {
+		return fmt.Errorf("failed to set font families: %w", err)
+	}
+
+	return nil
+}
+
+// SetFontSizes overrides the page's default font sizes in pixels
+func (p *Page) SetFontSizes(defaultSize, defaultMonospaceSize int) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	if defaultSize <= 0 || defaultMonospaceSize <= 0 {
+		return fmt.Errorf("font sizes must be positive, got %d and %d", defaultSize, defaultMonospaceSize)
+	}
+
+	err := proto.PageSetFontSizes{
+		FontSizes: &proto.PageFontSizes{
+			Standard: &defaultSize,
+			Fixed:    &defaultMonospaceSize,
+		},
+	}.Call(p.page)
+	if err != nil {
+		return fmt.Errorf("failed to set font sizes: %w", err)
+	}
+
+	return nil
+}
+
+// EnableTouchEmulation makes the page report touch support with up to maxTouchPoints simultaneous touches.
+// Feature detection such as 'ontouchstart' in window reflects it from the next navigation on.
+func (p *Page) EnableTouchEmulation(maxTouchPoints int) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	if maxTouchPoints < 1 || maxTouchPoints > 16 {
+		return fmt.Errorf("max touch points must be between 1 and 16, got %d", maxTouchPoints)
+	}
+
+	err := proto.EmulationSetTouchEmulationEnabled{Enabled: true, MaxTouchPoints: &maxTouchPoints}.Call(p.page)
+	if err != nil {
+		return fmt.Errorf("failed to enable touch emulation: %w", err)
+	}
+
+	return nil
+}
+
+// DisableTouchEmulation restores the page's native touch support
+func (p *Page) DisableTouchEmulation() error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	if err := (proto.EmulationSetTouchEmulationEnabled{Enabled: false}).Call(p.page); err != nil {
+		return fmt.Errorf("failed to disable touch emulation: %w", err)
+	}
+
+	return nil
+}
+
+// FitViewportToContent resizes the viewport to the document's scroll size, so a regular screenshot
+// captures the whole page. Dimensions are capped at MaxViewportWidth and MaxViewportHeight,
+// other device metrics set earlier are kept.
+func (p *Page) FitViewportToContent() error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	page := p.page.Context(p.ctx)
+
+	res, err := page.Eval(`() => ({
+		width: document.documentElement.scrollWidth,
+		height: document.documentElement.scrollHeight,
+	})`)
+	if err != nil {
+		return fmt.Errorf("failed to read content size: %w", err)
+	}
+
+	width := min(res.Value.Get("width").Int(), MaxViewportWidth)
+	height := min(res.Value.Get("height").Int(), MaxViewportHeight)
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("document has no size, got %dx%d", width, height)
+	}
+
+	// Keep the scale factor and mobile emulation of an earlier override
+	metrics := &proto.EmulationSetDeviceMetricsOverride{}
+	page.LoadState(metrics)
+	metrics.Width, metrics.Height = width, height
+
+	if err := page.SetViewport(metrics); err != nil {
+		return fmt.Errorf("failed to set viewport: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package rodwer
+
+import (
+	"testing"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/stretchr/testify/suite"
+)
+
+// EmulationTestSuite covers font, device and environment overrides
+type EmulationTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *EmulationTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *EmulationTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *EmulationTestSuite) TestFontOverrides() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetFontFamilies(map[string]string{"standard": "Courier New", "serif": "Georgia"})
+	s.Require().NoError(err)
+
+	err = page.SetFontSizes(20, 15)
+	s.Require().NoError(err)
+
+	// An unstyled body falls back to the standard font settings
+	err = page.Navigate("data:text/html,<html><body><p>Fonts</p><code>code</code></body></html>")
+	s.Require().NoError(err)
+
+	res, err := page.Evaluate(`() => ({
+		family: getComputedStyle(document.body).fontFamily,
+		size: getComputedStyle(document.body).fontSize,
+		monoSize: getComputedStyle(document.querySelector('code')).fontSize,
+	})`)
+	s.Require().NoError(err)
+
+	style := res.(map[string]interface{})
+	s.Contains(style["family"], "Courier New")
+	s.Equal("20px", style["size"])
+	s.Equal("15px", style["monoSize"])
+
+	s.Run("invalid input", func() {
+		s.Error(page.SetFontFamilies(map[string]string{"monospace": "Courier New"}))
+		s.Error(page.SetFontSizes(0, 13))
+	})
+}
+
+func (s *EmulationTestSuite) TestFitViewportToContent() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body style="margin: 0"><div style="width: 600px; height: 3000px"></div></body></html>`)
+	s.Require().NoError(err)
+
+	s.Require().NoError(page.FitViewportToContent())
+
+	height, err := page.Evaluate(`() => window.innerHeight`)
+	s.Require().NoError(err)
+	s.EqualValues(3000, height, "The viewport should grow to the content height")
+
+	s.Run("height is capped", func() {
+		err := page.SetContent(`<html><body style="margin: 0"><div style="height: 20000px"></div></body></html>`)
+		s.Require().NoError(err)
+
+		s.Require().NoError(page.FitViewportToContent())
+
+		height, err := page.Evaluate(`() => window.innerHeight`)
+		s.Require().NoError(err)
+		s.EqualValues(MaxViewportHeight, height)
+	})
+
+	s.Run("device metrics are kept", func() {
+		err := page.page.SetViewport(&proto.EmulationSetDeviceMetricsOverride{Width: 400, Height: 300, DeviceScaleFactor: 2, Mobile: true})
+		s.Require().NoError(err)
+
+		s.Require().NoError(page.FitViewportToContent())
+
+		ratio, err := page.Evaluate(`() => window.devicePixelRatio`)
+		s.Require().NoError(err)
+		s.EqualValues(2, ratio, "The device scale factor should survive the resize")
+	})
+}
+
+func (s *EmulationTestSuite) TestTouchEmulation() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	// Touch handlers are only installed when the browser reports touch support
+	html := `data:text/html,<html><body><div id="target">Tap</div><script>
+		if ('ontouchstart' in window) {
+			document.getElementById('target').addEventListener('touchstart', (e) => e.target.dataset.touched = 'true');
+		}
+	</script></body></html>`
+
+	tap := func() interface{} {
+		res, err := page.Evaluate(`() => {
+			const target = document.getElementById('target');
+			target.dispatchEvent(new Event('touchstart', { bubbles: true }));
+			return target.dataset.touched === 'true';
+		}`)
+		s.Require().NoError(err)
+		return res
+	}
+
+	s.Require().NoError(page.Navigate(html))
+	s.Equal(false, tap(), "Desktop pages should not handle touch")
+
+	s.Require().NoError(page.EnableTouchEmulation(5))
+	s.Require().NoError(page.Navigate(html))
+	s.Equal(true, tap(), "Touch handler should fire with touch emulation")
+
+	maxTouchPoints, err := page.Evaluate(`() => navigator.maxTouchPoints`)
+	s.Require().NoError(err)
+	s.EqualValues(5, maxTouchPoints)
+
+	s.Run("disable", func() {
+		s.Require().NoError(page.DisableTouchEmulation())
+		s.Require().NoError(page.Navigate(html))
+		s.Equal(false, tap())
+	})
+
+	s.Run("invalid touch points", func() {
+		s.Error(page.EnableTouchEmulation(0))
+	})
+}
+
+// Run the emulation test suite
+func TestEmulationSuite(t *testing.T) {
+	suite.Run(t, new(EmulationTestSuite))
+}
@@ -0,0 +1,202 @@
+package rodwer
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/ysmood/gson"
+)
+
+// isolatedWorldName names the execution contexts created by EvaluateJSIsolated
+const isolatedWorldName = "rodwer-isolated"
+
+// Evaluate runs a JavaScript function on the page with args as its arguments and returns its JSON decoded result
+func (p *Page) Evaluate(js string, args ...interface{}) (interface{}, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	defer p.browser.trackOp()()
+
+	res, err := p.page.Context(p.ctx).Eval(js, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate %q: %w", js, err)
+	}
+
+	return res.Value.Val(), nil
+}
+
+// EvalResult is the result of a JavaScript evaluation
+type EvalResult struct {
+	obj  *proto.RuntimeRemoteObject
+	page *Page
+}
+
+// Release frees the result's remote object in the browser, results returned by value hold none
+func (r *EvalResult) Release() error {
+	if r.obj.ObjectID == "" {
+		return nil
+	}
+
+	if r.page != nil {
+		defer r.page.untrackObject(r.obj.ObjectID)
+
+		if err := (proto.RuntimeReleaseObject{ObjectID: r.obj.ObjectID}).Call(r.page.page); err != nil {
+			return fmt.Errorf("failed to release evaluation result: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// String returns the result as a string, non-string values are rendered as JSON
+func (r *EvalResult) String() string {
+	if str, ok := r.obj.Value.Val().(string); ok {
+		return str
+	}
+	return r.obj.Value.JSON("", "")
+}
+
+// Int returns the result as an int
+func (r *EvalResult) Int() int {
+	return r.obj.Value.Int()
+}
+
+// Float returns the result as a float64
+func (r *EvalResult) Float() float64 {
+	return r.obj.Value.Num()
+}
+
+// Bool returns the result as a bool
+func (r *EvalResult) Bool() bool {
+	return r.obj.Value.Bool()
+}
+
+// JSON unmarshals the result into v
+func (r *EvalResult) JSON(v interface{}) error {
+	if err := r.obj.Value.Unmarshal(v); err != nil {
+		return fmt.Errorf("failed to decode evaluation result: %w", err)
+	}
+	return nil
+}
+
+// EvalJS evaluates a JavaScript expression, e.g. "document.title", or function, e.g. "(a, b) => a + b", with args
+func (p *Page) EvalJS(expression string, args ...interface{}) (*EvalResult, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	defer p.browser.trackOp()()
+
+	return p.trackResult(evalJS(p.page.Context(p.ctx), expression, args...))
+}
+
+// trackResult counts the remote object held by result as an open handle of the page
+func (p *Page) trackResult(result *EvalResult, err error) (*EvalResult, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	result.page = p
+	p.trackObject(result.obj.ObjectID)
+
+	return result, nil
+}
+
+// evalJS evaluates expression on page, wrapping plain expressions into a function first
+func evalJS(page *rod.Page, expression string, args ...interface{}) (*EvalResult, error) {
+	res, err := page.Eval(asJSFunction(expression), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate %q: %w", expression, err)
+	}
+
+	return &EvalResult{obj: res}, nil
+}
+
+// EvalAsync evaluates a JavaScript expression or function like EvalJS and waits for a returned promise,
+// returning its resolved value. A rejected promise is returned as an error.
+func (p *Page) EvalAsync(js string, args ...interface{}) (gson.JSON, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return gson.New(nil), fmt.Errorf("page is closed")
+	}
+
+	defer p.browser.trackOp()()
+
+	res, err := p.page.Context(p.ctx).Evaluate(rod.Eval(asJSFunction(js), args...).ByPromise())
+	if err != nil {
+		return gson.New(nil), fmt.Errorf("failed to evaluate %q: %w", js, err)
+	}
+
+	return res.Value, nil
+}
+
+// jsFunctionPattern matches the start of function and arrow function definitions
+var jsFunctionPattern = regexp.MustCompile(`^\s*(async\s+)?(function\b|\([^)]*\)\s*=>|[A-Za-z_$][\w$]*\s*=>)`)
+
+// asJSFunction returns expression unchanged when it defines a function, otherwise a function returning it
+func asJSFunction(expression string) string {
+	if jsFunctionPattern.MatchString(expression) {
+		return expression
+	}
+	return "() => (" + expression + ")"
+}
+
+// EvaluateJSIsolated evaluates a JavaScript function in a fresh isolated world that shares the DOM but not the page's globals
+func (p *Page) EvaluateJSIsolated(script string, args ...interface{}) (interface{}, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	defer p.browser.trackOp()()
+
+	page := p.page.Context(p.ctx)
+
+	world, err := proto.PageCreateIsolatedWorld{
+		FrameID:   page.FrameID,
+		WorldName: isolatedWorldName,
+	}.Call(page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create isolated world: %w", err)
+	}
+
+	callArgs := make([]*proto.RuntimeCallArgument, len(args))
+	for i, arg := range args {
+		callArgs[i] = &proto.RuntimeCallArgument{Value: gson.New(arg)}
+	}
+
+	// Same wrapping as rod's Eval so scripts are written as functions
+	res, err := proto.RuntimeCallFunctionOn{
+		FunctionDeclaration: fmt.Sprintf("function() { return (%s).apply(this, arguments) }", script),
+		Arguments:           callArgs,
+		ExecutionContextID:  world.ExecutionContextID,
+		ReturnByValue:       true,
+		AwaitPromise:        true,
+	}.Call(page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate script in isolated world: %w", err)
+	}
+
+	if res.ExceptionDetails != nil {
+		return nil, fmt.Errorf("failed to evaluate script in isolated world: %w", &rod.EvalError{RuntimeExceptionDetails: res.ExceptionDetails})
+	}
+
+	return res.Result.Value.Val(), nil
+}
@@ -0,0 +1,258 @@
+package rodwer
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/ysmood/gson"
+)
+
+// EvalTestSuite covers JavaScript evaluation helpers
+type EvalTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *EvalTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *EvalTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *EvalTestSuite) TestEvaluate() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.Navigate("data:text/html,<html><head><title>Evaluate</title></head><body></body></html>")
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name string
+		js   string
+		args []interface{}
+		want interface{}
+	}{
+		{name: "expression result", js: `() => 1 + 2`, want: 3.0},
+		{name: "args are passed", js: `(a, b) => a + b`, args: []interface{}{4, 5}, want: 9.0},
+		{name: "page state", js: `() => document.readyState`, want: "complete"},
+		{name: "objects are decoded", js: `(name) => ({ title: document.title, name: name })`, args: []interface{}{"rodwer"},
+			want: map[string]interface{}{"title": "Evaluate", "name": "rodwer"}},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			got, err := page.Evaluate(tt.js, tt.args...)
+			s.Require().NoError(err)
+			s.Equal(tt.want, got)
+		})
+	}
+
+	s.Run("errors include the failing snippet", func() {
+		_, err := page.Evaluate(`() => missingHelper()`)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "missingHelper()")
+	})
+
+	s.Run("closed page", func() {
+		closedPage, err := s.browser.NewPage()
+		s.Require().NoError(err)
+		s.Require().NoError(closedPage.Close())
+
+		_, err = closedPage.Evaluate(`() => 1`)
+		s.Error(err)
+	})
+}
+
+func (s *EvalTestSuite) TestEvaluateJSIsolated() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	html := `
+	<html>
+	<head><title>Isolation</title></head>
+	<body>
+		<script>window.pageVar = 'page';</script>
+	</body>
+	</html>`
+
+	err = page.Navigate("data:text/html," + html)
+	s.Require().NoError(err)
+
+	s.Run("page globals are hidden from the isolated world", func() {
+		res, err := page.EvaluateJSIsolated(`() => { window.isolatedVar = 'isolated'; return typeof window.pageVar }`)
+		s.Require().NoError(err)
+		s.Equal("undefined", res)
+	})
+
+	s.Run("isolated globals are hidden from the page", func() {
+		res, err := page.page.Eval(`() => typeof window.isolatedVar`)
+		s.Require().NoError(err)
+		s.Equal("undefined", res.Value.Str())
+	})
+
+	s.Run("DOM is shared and args are passed", func() {
+		res, err := page.EvaluateJSIsolated(`(suffix) => document.title + suffix`, "!")
+		s.Require().NoError(err)
+		s.Equal("Isolation!", res)
+	})
+
+	s.Run("exceptions are returned as errors", func() {
+		_, err := page.EvaluateJSIsolated(`() => { throw new Error('boom') }`)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "boom")
+	})
+}
+
+func (s *EvalTestSuite) TestEvalJS() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><head><title>List</title></head><body><ul><li>a</li><li>b</li><li>c</li></ul></body></html>`)
+	s.Require().NoError(err)
+
+	s.Run("plain expression", func() {
+		result, err := page.EvalJS("document.querySelectorAll('li').length")
+		s.Require().NoError(err)
+		s.Equal(3, result.Int())
+	})
+
+	s.Run("function with args", func() {
+		result, err := page.EvalJS("(a, b) => a / b", 7, 2)
+		s.Require().NoError(err)
+		s.Equal(3.5, result.Float())
+	})
+
+	s.Run("typed helpers", func() {
+		result, err := page.EvalJS("document.title")
+		s.Require().NoError(err)
+		s.Equal("List", result.String())
+
+		result, err = page.EvalJS("document.title === 'List'")
+		s.Require().NoError(err)
+		s.True(result.Bool())
+	})
+
+	s.Run("non-string values render as JSON", func() {
+		result, err := page.EvalJS("({ a: 1, b: 'x' })")
+		s.Require().NoError(err)
+		s.Equal(`{"a":1,"b":"x"}`, result.String())
+
+		result, err = page.EvalJS("[1, 2]")
+		s.Require().NoError(err)
+		s.Equal("[1,2]", result.String())
+	})
+
+	s.Run("decode into struct", func() {
+		result, err := page.EvalJS("({ title: document.title, items: Array.from(document.querySelectorAll('li')).map(li => li.textContent) })")
+		s.Require().NoError(err)
+
+		var state struct {
+			Title string   `json:"title"`
+			Items []string `json:"items"`
+		}
+		s.Require().NoError(result.JSON(&state))
+		s.Equal("List", state.Title)
+		s.Equal([]string{"a", "b", "c"}, state.Items)
+	})
+
+	s.Run("evaluation errors", func() {
+		_, err := page.EvalJS("undefinedVariable.length")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "undefinedVariable.length")
+	})
+}
+
+func (s *EvalTestSuite) TestEvalAsync() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/api/user", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "Ada", "roles": ["admin", "dev"]}`))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL))
+
+	s.Run("awaits fetch", func() {
+		user, err := page.EvalAsync(`() => fetch('/api/user').then(r => r.json())`)
+		s.Require().NoError(err)
+		s.Equal("Ada", user.Get("name").Str())
+		s.Equal("dev", user.Get("roles.1").Str())
+	})
+
+	s.Run("resolving promise with args", func() {
+		sum, err := page.EvalAsync(`(a, b) => new Promise(resolve => setTimeout(() => resolve(a + b), 50))`, 2, 3)
+		s.Require().NoError(err)
+		s.Equal(5, sum.Int())
+	})
+
+	s.Run("plain expression", func() {
+		title, err := page.EvalAsync(`Promise.resolve('ready')`)
+		s.Require().NoError(err)
+		s.Equal("ready", title.Str())
+	})
+
+	s.Run("rejecting promise", func() {
+		_, err := page.EvalAsync(`() => Promise.reject(new Error('request denied'))`)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "request denied")
+	})
+}
+
+func TestEvalResultString(t *testing.T) {
+	result := func(v interface{}) *EvalResult {
+		return &EvalResult{obj: &proto.RuntimeRemoteObject{Value: gson.New(v)}}
+	}
+
+	assert.Equal(t, "List", result("List").String(), "Strings are returned unquoted")
+	assert.Equal(t, `{"a":1}`, result(map[string]int{"a": 1}).String())
+	assert.Equal(t, "[1,2]", result([]int{1, 2}).String())
+	assert.Equal(t, "3.5", result(3.5).String())
+}
+
+func TestAsJSFunction(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{"document.title", "() => (document.title)"},
+		{"1 + 2", "() => (1 + 2)"},
+		{"({ a: 1 })", "() => (({ a: 1 }))"},
+		{"() => 1", "() => 1"},
+		{"(a, b) => a + b", "(a, b) => a + b"},
+		{"x => x * 2", "x => x * 2"},
+		{"async () => await fetch('/')", "async () => await fetch('/')"},
+		{"function() { return this }", "function() { return this }"},
+		{"  function named(a) { return a }", "  function named(a) { return a }"},
+		{"functionCall()", "() => (functionCall())"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			assert.Equal(t, tt.want, asJSFunction(tt.expression))
+		})
+	}
+}
+
+// Run the eval test suite
+func TestEvalSuite(t *testing.T) {
+	suite.Run(t, new(EvalTestSuite))
+}
@@ -0,0 +1,151 @@
+package rodwer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// fetchInterceptor shares the page's Fetch domain between Route, PauseNetwork, SetInterceptContentTypes
+// and SetContentAndHeaders. Fetch is enabled with the patterns of every user while at least one is
+// registered, and a single listener offers each paused request to the users.
+type fetchInterceptor struct {
+	users []*fetchUser // guarded by the page's mu
+
+	sync sync.Mutex         // serializes Fetch.enable and Fetch.disable calls
+	stop context.CancelFunc // stops the listener while Fetch is enabled, guarded by sync
+}
+
+// fetchUser is one interception sharing the Fetch domain
+type fetchUser struct {
+	patterns []*proto.FetchRequestPattern // guarded by the page's mu
+	// take handles a paused request and reports whether it did, declined requests go to older users
+	take func(page *rod.Page, e *proto.FetchRequestPaused) bool
+	// first users are offered requests before all others, so PauseNetwork also holds requests of routes added later
+	first bool
+}
+
+// acquireFetch registers user and enables Fetch with its patterns added
+func (p *Page) acquireFetch(user *fetchUser) error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return fmt.Errorf("page is closed")
+	}
+	if p.fetch == nil {
+		p.fetch = &fetchInterceptor{}
+	}
+	p.fetch.users = append(p.fetch.users, user)
+	p.mu.Unlock()
+
+	if err := p.syncFetch(); err != nil {
+		_ = p.releaseFetch(user)
+		return err
+	}
+
+	return nil
+}
+
+// releaseFetch unregisters user, Fetch is disabled once no user is left
+func (p *Page) releaseFetch(user *fetchUser) error {
+	p.removeFetchUser(user)
+	return p.syncFetch()
+}
+
+// removeFetchUser unregisters user without updating the Fetch domain yet
+func (p *Page) removeFetchUser(user *fetchUser) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.fetch == nil {
+		return
+	}
+
+	for i, u := range p.fetch.users {
+		if u == user {
+			p.fetch.users = append(p.fetch.users[:i:i], p.fetch.users[i+1:]...)
+			break
+		}
+	}
+}
+
+// syncFetch enables Fetch with the patterns of all users, or disables it when there are none
+func (p *Page) syncFetch() error {
+	p.mu.RLock()
+	f := p.fetch
+	p.mu.RUnlock()
+
+	if f == nil {
+		return nil
+	}
+
+	f.sync.Lock()
+	defer f.sync.Unlock()
+
+	// Read the users under sync, so the last call applies the latest state
+	p.mu.RLock()
+	closed := p.closed
+	users := len(f.users)
+	var patterns []*proto.FetchRequestPattern
+	for _, user := range f.users {
+		patterns = append(patterns, user.patterns...)
+	}
+	p.mu.RUnlock()
+
+	if closed {
+		return nil
+	}
+
+	if users == 0 {
+		if f.stop == nil {
+			return nil
+		}
+		f.stop()
+		f.stop = nil
+		if err := (proto.FetchDisable{}).Call(p.page.Context(p.ctx)); err != nil {
+			return fmt.Errorf("failed to disable fetch interception: %w", err)
+		}
+		return nil
+	}
+
+	if err := (proto.FetchEnable{Patterns: patterns}).Call(p.page.Context(p.ctx)); err != nil {
+		return fmt.Errorf("failed to enable fetch interception: %w", err)
+	}
+
+	// Fetch is enabled first, so the listener doesn't enable it with default patterns or disable it when stopped
+	if f.stop == nil {
+		ctx, cancel := context.WithCancel(p.ctx)
+		page := p.page.Context(ctx)
+		wait := page.EachEvent(func(e *proto.FetchRequestPaused) {
+			go func() { _ = p.dispatchFetch(page, e) }()
+		})
+		p.listen(wait)
+		f.stop = cancel
+	}
+
+	return nil
+}
+
+// dispatchFetch offers a paused request to the first users and then the others, newest first,
+// and continues it when none takes it
+func (p *Page) dispatchFetch(page *rod.Page, e *proto.FetchRequestPaused) error {
+	p.mu.RLock()
+	var users []*fetchUser
+	if p.fetch != nil {
+		users = append(users, p.fetch.users...)
+	}
+	p.mu.RUnlock()
+
+	for _, first := range []bool{true, false} {
+		for i := len(users) - 1; i >= 0; i-- {
+			if users[i].first == first && users[i].take(page, e) {
+				return nil
+			}
+		}
+	}
+
+	return proto.FetchContinueRequest{RequestID: e.RequestID}.Call(page)
+}
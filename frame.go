@@ -0,0 +1,147 @@
+package rodwer
+
+import (
+	"fmt"
+
+	"github.com/go-rod/rod"
+)
+
+// Frame is the document of an <iframe> or <frame> element
+type Frame struct {
+	frame *rod.Page
+	page  *Page
+}
+
+// Frames returns the frames embedded in the page's document, in document order
+func (p *Page) Frames() ([]*Frame, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	elements, err := p.page.Context(p.ctx).Elements("iframe, frame")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find frames: %w", err)
+	}
+
+	frames := make([]*Frame, 0, len(elements))
+	for _, el := range elements {
+		frame, err := p.frameOf(el)
+		if err != nil {
+			return nil, err
+		}
+		frames = append(frames, frame)
+	}
+
+	return frames, nil
+}
+
+// Frame returns the frame of the <iframe> or <frame> element matching selector
+func (p *Page) Frame(selector string) (*Frame, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	el, err := p.page.Context(p.ctx).Element(selector)
+	if err != nil {
+		return nil, fmt.Errorf("element not found: %s", selector)
+	}
+
+	res, err := el.Eval(`() => this.tagName === 'IFRAME' || this.tagName === 'FRAME'`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect %s: %w", selector, err)
+	}
+	if !res.Value.Bool() {
+		return nil, fmt.Errorf("element %s is not a frame", selector)
+	}
+
+	return p.frameOf(el)
+}
+
+// frameOf returns the frame hosted by el
+func (p *Page) frameOf(el *rod.Element) (*Frame, error) {
+	frame, err := el.Frame()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get frame: %w", err)
+	}
+
+	return &Frame{frame: frame, page: p}, nil
+}
+
+// Element finds an element by selector inside the frame
+func (f *Frame) Element(selector string) (Element, error) {
+	if err := f.checkOpen(); err != nil {
+		return Element{}, err
+	}
+
+	rodElement, err := f.frame.Element(selector)
+	if err != nil {
+		return Element{}, fmt.Errorf("element not found in frame: %s", selector)
+	}
+
+	return f.page.newElement(rodElement), nil
+}
+
+// Elements finds the elements matching selector inside the frame
+func (f *Frame) Elements(selector string) ([]Element, error) {
+	if err := f.checkOpen(); err != nil {
+		return nil, err
+	}
+
+	rodElements, err := f.frame.Elements(selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find elements in frame: %s", selector)
+	}
+
+	elements := make([]Element, len(rodElements))
+	for i, rodElement := range rodElements {
+		elements[i] = f.page.newElement(rodElement)
+	}
+
+	return elements, nil
+}
+
+// EvalJS evaluates a JavaScript expression or function with args in the frame's document
+func (f *Frame) EvalJS(expression string, args ...interface{}) (*EvalResult, error) {
+	if err := f.checkOpen(); err != nil {
+		return nil, err
+	}
+
+	defer f.page.browser.trackOp()()
+
+	return f.page.trackResult(evalJS(f.frame, expression, args...))
+}
+
+// URL returns the URL of the frame's document, empty when it can't be read
+func (f *Frame) URL() string {
+	if f.checkOpen() != nil {
+		return ""
+	}
+
+	res, err := f.frame.Eval(`() => location.href`)
+	if err != nil {
+		return ""
+	}
+
+	return res.Value.Str()
+}
+
+// checkOpen returns an error once the frame's page is closed
+func (f *Frame) checkOpen() error {
+	f.page.mu.RLock()
+	closed := f.page.closed
+	f.page.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	return nil
+}
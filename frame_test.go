@@ -0,0 +1,103 @@
+package rodwer
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// FrameTestSuite covers iframe access
+type FrameTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *FrameTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *FrameTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *FrameTestSuite) TestFrames() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	html := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte(body))
+		}
+	}
+	testServer.AddRoute("/shop", html(`<html><body>
+		<h1 id="title">Shop</h1>
+		<iframe id="checkout" src="/shop/checkout"></iframe>
+		<iframe id="chat" src="/shop/chat"></iframe>
+	</body></html>`))
+	testServer.AddRoute("/shop/checkout", html(`<html><head><title>Checkout</title></head><body>
+		<button id="pay">Pay now</button>
+		<input class="field" name="card"><input class="field" name="cvc">
+	</body></html>`))
+	testServer.AddRoute("/shop/chat", html(`<html><head><title>Chat</title></head><body>Hi</body></html>`))
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL + "/shop"))
+
+	s.Run("all frames", func() {
+		frames, err := page.Frames()
+		s.Require().NoError(err)
+		s.Require().Len(frames, 2)
+		s.Equal(testServer.URL+"/shop/checkout", frames[0].URL())
+		s.Equal(testServer.URL+"/shop/chat", frames[1].URL())
+	})
+
+	s.Run("frame by selector", func() {
+		frame, err := page.Frame("#checkout")
+		s.Require().NoError(err)
+
+		button, err := frame.Element("#pay")
+		s.Require().NoError(err)
+		text, err := button.Text()
+		s.Require().NoError(err)
+		s.Equal("Pay now", text)
+
+		fields, err := frame.Elements(".field")
+		s.Require().NoError(err)
+		s.Len(fields, 2)
+
+		title, err := frame.EvalJS(`document.title`)
+		s.Require().NoError(err)
+		s.Equal("Checkout", title.String())
+	})
+
+	s.Run("frame elements are isolated from the page", func() {
+		frame, err := page.Frame("#chat")
+		s.Require().NoError(err)
+
+		elements, err := frame.Elements("#title")
+		s.Require().NoError(err)
+		s.Empty(elements)
+	})
+
+	s.Run("selector is not a frame", func() {
+		_, err := page.Frame("#title")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "not a frame")
+	})
+}
+
+// Run the frame test suite
+func TestFrameSuite(t *testing.T) {
+	suite.Run(t, new(FrameTestSuite))
+}
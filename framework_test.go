@@ -1,9 +1,17 @@
 package rodwer
 
 import (
+	"bytes"
 	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -323,6 +331,14 @@ func (s *FrameworkTestSuite) TestScreenshotCapture() {
 			},
 			wantErr: false,
 		},
+		{
+			name: "screenshot with blank retry",
+			options: ScreenshotOptions{
+				Format:     "png",
+				RetryBlank: true,
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -376,6 +392,178 @@ func (s *FrameworkTestSuite) TestScreenshotToFile() {
 	s.Error(err, "Should error with empty file path")
 }
 
+func (s *FrameworkTestSuite) TestScreenshotTiles() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body style="margin: 0">
+		<div style="height: 100vh; background: red"></div>
+		<div style="height: 100vh; background: green"></div>
+		<div style="height: 100vh; background: blue"></div>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	_, err = page.Evaluate(`() => window.scrollTo(0, 100)`)
+	s.Require().NoError(err)
+
+	viewportHeight, err := page.Evaluate(`() => window.innerHeight`)
+	s.Require().NoError(err)
+
+	tiles, err := page.ScreenshotTiles(ScreenshotOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(tiles, 3, "A page three viewports tall gives three tiles")
+
+	for i, tile := range tiles {
+		cfg, err := png.DecodeConfig(bytes.NewReader(tile))
+		s.Require().NoError(err, "tile %d", i)
+		s.InDelta(viewportHeight.(float64), float64(cfg.Height), 1, "tile %d should be one viewport tall", i)
+	}
+
+	scrollY, err := page.Evaluate(`() => window.scrollY`)
+	s.Require().NoError(err)
+	s.EqualValues(100, scrollY, "The scroll position is restored")
+}
+
+func (s *FrameworkTestSuite) TestScreenshotGIF() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body><div id="box" style="width: 100px; height: 100px; background: red"></div></body></html>`)
+	s.Require().NoError(err)
+
+	data, err := page.ScreenshotGIF(GIFScreenshotOptions{Frames: 3, FrameDelay: 100 * time.Millisecond})
+	s.Require().NoError(err)
+	s.True(bytes.HasPrefix(data, []byte("GIF89a")), "Output should be a GIF")
+
+	anim, err := gif.DecodeAll(bytes.NewReader(data))
+	s.Require().NoError(err)
+	s.Len(anim.Image, 3)
+	s.Equal([]int{10, 10, 10}, anim.Delay)
+
+	_, err = page.ScreenshotGIF(GIFScreenshotOptions{})
+	s.Error(err, "Zero frames should be rejected")
+}
+
+func (s *FrameworkTestSuite) TestElementScreenshotWaitImages() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	var red bytes.Buffer
+	img := image.NewRGBA(image.Rect(0, 0, 40, 40))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: color.RGBA{R: 255, A: 255}}, image.Point{}, draw.Src)
+	s.Require().NoError(png.Encode(&red, img))
+
+	testServer.AddRoute("/slow.png", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Second)
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(red.Bytes())
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.SetContent(`<html><body style="margin: 0">
+		<div id="card" style="width: 40px; height: 40px; background: white"></div>
+	</body></html>`))
+
+	// Start loading the image after the page has loaded so it is still pending at capture time
+	_, err = page.Evaluate(`(src) => {
+		const img = document.createElement('img');
+		img.src = src;
+		img.style = 'display: block; width: 40px; height: 40px';
+		document.getElementById('card').appendChild(img);
+	}`, testServer.URL+"/slow.png")
+	s.Require().NoError(err)
+
+	card, err := page.Element("#card")
+	s.Require().NoError(err)
+
+	data, err := card.Screenshot(ScreenshotOptions{WaitImages: true})
+	s.Require().NoError(err)
+
+	shot, err := png.Decode(bytes.NewReader(data))
+	s.Require().NoError(err)
+
+	center := shot.Bounds().Min.Add(image.Pt(shot.Bounds().Dx()/2, shot.Bounds().Dy()/2))
+	r, g, b, _ := shot.At(center.X, center.Y).RGBA()
+	s.Greater(r>>8, uint32(200), "The image area should be rendered red")
+	s.Less(g>>8, uint32(50))
+	s.Less(b>>8, uint32(50))
+}
+
+func (s *FrameworkTestSuite) TestElementScreenshotScrollIntoView() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.SetContent(`<html><body style="margin: 0">
+		<div style="height: 5000px"></div>
+		<div id="far" style="width: 40px; height: 40px; background: red"></div>
+	</body></html>`))
+
+	far, err := page.Element("#far")
+	s.Require().NoError(err)
+
+	scrollY := func() float64 {
+		res, err := page.Evaluate(`() => window.scrollY`)
+		s.Require().NoError(err)
+		return res.(float64)
+	}
+
+	_, err = far.Screenshot()
+	s.Require().NoError(err)
+	s.Zero(scrollY(), "The scroll position is kept by default")
+
+	data, err := far.Screenshot(ScreenshotOptions{ScrollIntoView: true})
+	s.Require().NoError(err)
+	s.Greater(scrollY(), 0.0, "The element is scrolled into view when asked")
+
+	shot, err := png.Decode(bytes.NewReader(data))
+	s.Require().NoError(err)
+	r, g, _, _ := shot.At(shot.Bounds().Dx()/2, shot.Bounds().Dy()/2).RGBA()
+	s.Greater(r>>8, uint32(200), "The scrolled element should be captured")
+	s.Less(g>>8, uint32(50))
+}
+
+// recordingTB collects cleanups instead of running them and reports a fixed failure state
+type recordingTB struct {
+	testing.TB
+	failed   bool
+	cleanups []func()
+}
+
+func (r *recordingTB) Failed() bool      { return r.failed }
+func (r *recordingTB) Cleanup(fn func()) { r.cleanups = append(r.cleanups, fn) }
+
+func (s *FrameworkTestSuite) TestCaptureOnFailure() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.SetContent(`<html><body><h1>Broken state</h1></body></html>`))
+
+	for _, failed := range []bool{true, false} {
+		dir := s.T().TempDir()
+		tb := &recordingTB{TB: s.T(), failed: failed}
+
+		CaptureOnFailure(tb, page, dir)
+		s.Require().Len(tb.cleanups, 1)
+		tb.cleanups[0]()
+
+		files, err := os.ReadDir(dir)
+		s.Require().NoError(err)
+		if failed {
+			s.Require().Len(files, 1, "A failed test leaves a screenshot")
+			s.Equal(screenshotFileName(s.T().Name()), files[0].Name())
+		} else {
+			s.Empty(files, "A passing test leaves no screenshot")
+		}
+	}
+}
+
 func (s *FrameworkTestSuite) TestCoverageCollection() {
 	page, err := s.browser.NewPage()
 	s.Require().NoError(err)
@@ -421,6 +609,131 @@ func (s *FrameworkTestSuite) TestCoverageCollection() {
 	}
 }
 
+func (s *FrameworkTestSuite) TestCoverageURLFilter() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/filtered", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body>
+			<script src="/js/app.js"></script>
+			<script src="/js/vendor.js"></script>
+		</body></html>`))
+	})
+	testServer.AddRoute("/js/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/javascript")
+		w.Write([]byte("function loaded() { return '" + r.URL.Path + "'; }\nloaded();"))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.StartJSCoverage(StartJSCoverageOptions{URLFilter: []string{"*/js/app.js"}})
+	s.Require().NoError(err)
+
+	err = page.Navigate(testServer.URL + "/filtered")
+	s.Require().NoError(err)
+
+	coverage, err := page.StopJSCoverageWithWait(JSCoverageOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(coverage, 1, "Only scripts matching the URL filter should be reported")
+	s.True(strings.HasSuffix(coverage[0].URL, "/js/app.js"))
+	s.Contains(coverage[0].Source, "/js/app.js")
+
+	s.Run("restart without filter collects everything", func() {
+		err := page.StartJSCoverage()
+		s.Require().NoError(err)
+
+		err = page.Navigate(testServer.URL + "/filtered")
+		s.Require().NoError(err)
+
+		coverage, err := page.StopJSCoverageWithWait(JSCoverageOptions{})
+		s.Require().NoError(err)
+		s.GreaterOrEqual(len(coverage), 2)
+	})
+}
+
+func (s *FrameworkTestSuite) TestCoverageForElement() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.StartJSCoverage()
+	s.Require().NoError(err)
+
+	html := `<html><body>
+		<div id="widget"><script>function widgetInit() { return 'ready'; }
+function widgetUnused() { return 'never'; }
+widgetInit();</script></div>
+		<script>function pageScript() { return 'page'; } pageScript();</script>
+	</body></html>`
+
+	err = page.Navigate("data:text/html," + html)
+	s.Require().NoError(err)
+
+	coverage, err := page.CoverageForElement("#widget")
+	s.Require().NoError(err)
+	s.Require().Len(coverage.Scripts, 1, "Only the widget's script should be reported")
+	s.Contains(coverage.Scripts[0].Source, "widgetInit")
+	s.NotContains(coverage.Scripts[0].Source, "pageScript")
+
+	// Top-level script body, widgetInit and widgetUnused; all but widgetUnused ran
+	s.Equal(3, coverage.Aggregate.Functions.Total)
+	s.Equal(2, coverage.Aggregate.Functions.Covered)
+	s.Greater(coverage.Aggregate.Statements.Pct, 0.0)
+	s.Less(coverage.Aggregate.Statements.Pct, 100.0)
+
+	s.Run("script element itself and missing element", func() {
+		coverage, err := page.CoverageForElement("body > script")
+		s.Require().NoError(err)
+		s.Len(coverage.Scripts, 1)
+
+		_, err = page.CoverageForElement("#missing")
+		s.Error(err)
+	})
+
+	_, err = page.StopJSCoverageWithWait(JSCoverageOptions{})
+	s.Require().NoError(err)
+}
+
+func (s *FrameworkTestSuite) TestCoverageWaitsForStableScripts() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.StartJSCoverage())
+
+	// Lazy chunks keep arriving for over a second, longer than the fixed async delay
+	err = page.SetContent(`<html><body><script>
+		let chunk = 0;
+		const timer = setInterval(() => {
+			chunk++;
+			const script = document.createElement('script');
+			script.textContent = 'window.chunk' + chunk + ' = ' + chunk + ';\n//# sourceURL=chunk-' + chunk + '.js';
+			document.body.appendChild(script);
+			if (chunk === 6) clearInterval(timer);
+		}, 200);
+	</script></body></html>`)
+	s.Require().NoError(err)
+
+	coverage, err := page.StopJSCoverageWithWait(JSCoverageOptions{
+		WaitForAsyncFunctions: true,
+		AsyncWaitTimeout:      5 * time.Second,
+		StableCountThreshold:  3,
+		PollInterval:          100 * time.Millisecond,
+	})
+	s.Require().NoError(err)
+
+	var chunks []string
+	for _, entry := range coverage {
+		if strings.HasPrefix(entry.URL, "chunk-") {
+			chunks = append(chunks, entry.URL)
+		}
+	}
+	s.Len(chunks, 6, "Coverage should wait until no new scripts appear, got %v", chunks)
+}
+
 func (s *FrameworkTestSuite) TestMultiplePages() {
 	// Test creating and managing multiple pages
 	var pages []*Page
@@ -505,6 +818,26 @@ func TestBrowserOptionsValidation(t *testing.T) {
 			},
 			wantErr: false, // Empty is actually valid, will use default
 		},
+		{
+			name: "incomplete proxy rule",
+			options: BrowserOptions{
+				Headless: true,
+				Proxy: &ProxyConfig{
+					Rules: []ProxyRule{{URLPattern: "*://api.example.com/*"}},
+				},
+			},
+			wantErr: true,
+			errMsg:  "proxy rule 0 has no proxy server",
+		},
+		{
+			name: "negative navigation timeout",
+			options: BrowserOptions{
+				Headless:          true,
+				NavigationTimeout: -time.Second,
+			},
+			wantErr: true,
+			errMsg:  "navigation timeout must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -540,6 +873,10 @@ func TestTestHelpers(t *testing.T) {
 		assert.Equal(t, 200, resp.StatusCode)
 	})
 
+	t.Run("screenshot file name", func(t *testing.T) {
+		assert.Equal(t, "TestSuite_case_one.png", screenshotFileName("TestSuite/case one"))
+	})
+
 	// Test page factory functionality removed - functionality moved to test_base.go helpers
 }
 
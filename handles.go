@@ -0,0 +1,92 @@
+package rodwer
+
+import (
+	"sync"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// OpenHandles returns the number of CDP event listeners and remote objects the page wrapper currently holds open.
+// Remote objects are held by Elements and EvalResults until they are released or the page is closed.
+func (p *Page) OpenHandles() int {
+	return int(p.handles.Load())
+}
+
+// OpenHandles returns the number of CDP event listeners and remote objects held open across all pages of the browser
+func (b *Browser) OpenHandles() int {
+	return int(b.handles.Load())
+}
+
+// listen runs an EachEvent wait function in the background, counting it as open until it returns
+func (p *Page) listen(wait func()) {
+	release := p.trackHandle()
+	go func() {
+		defer release()
+		wait()
+	}()
+}
+
+// trackHandle counts an open handle on the page and its browser and returns the function releasing it
+func (p *Page) trackHandle() func() {
+	p.handles.Add(1)
+	p.browser.handles.Add(1)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.handles.Add(-1)
+			p.browser.handles.Add(-1)
+		})
+	}
+}
+
+// newElement wraps el, counting its remote object as open until the element is released
+func (p *Page) newElement(el *rod.Element) Element {
+	p.trackObject(el.Object.ObjectID)
+	return Element{
+		element: el,
+		page:    p,
+	}
+}
+
+// trackObject counts the remote object id as an open handle, once however many wrappers share it
+func (p *Page) trackObject(id proto.RuntimeRemoteObjectID) {
+	if id == "" {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return
+	}
+
+	if p.objects == nil {
+		p.objects = make(map[proto.RuntimeRemoteObjectID]func())
+	}
+	if _, ok := p.objects[id]; !ok {
+		p.objects[id] = p.trackHandle()
+	}
+}
+
+// untrackObject stops counting the remote object id, after it was released in the browser
+func (p *Page) untrackObject(id proto.RuntimeRemoteObjectID) {
+	p.mu.Lock()
+	release, ok := p.objects[id]
+	delete(p.objects, id)
+	p.mu.Unlock()
+
+	if ok {
+		release()
+	}
+}
+
+// releaseObjects stops counting every remote object of the page, the caller must hold p.mu
+func (p *Page) releaseObjects() {
+	for _, release := range p.objects {
+		release()
+	}
+	p.objects = nil
+}
@@ -0,0 +1,146 @@
+package rodwer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// HandlesTestSuite covers handle leak detection
+type HandlesTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *HandlesTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *HandlesTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *HandlesTestSuite) TestOpenHandles() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.Navigate("data:text/html,<html><body><h1>Handles</h1></body></html>")
+	s.Require().NoError(err)
+
+	pageBaseline := page.OpenHandles()
+	browserBaseline := s.browser.OpenHandles()
+
+	stop, err := page.SetInterceptContentTypes([]string{"application/json"}, func(req *InterceptedRequest) *InterceptResponse {
+		return nil
+	})
+	s.Require().NoError(err)
+
+	s.Equal(pageBaseline+1, page.OpenHandles(), "Interception should hold a listener open")
+	s.Equal(browserBaseline+1, s.browser.OpenHandles(), "Browser should aggregate page handles")
+
+	stop()
+
+	s.Eventually(func() bool {
+		return page.OpenHandles() == pageBaseline && s.browser.OpenHandles() == browserBaseline
+	}, 2*time.Second, 50*time.Millisecond, "Handles should return to baseline after stop")
+
+	s.Run("elements count until released", func() {
+		heading, err := page.Element("h1")
+		s.Require().NoError(err)
+		items, err := page.Elements("body *")
+		s.Require().NoError(err)
+
+		s.Equal(pageBaseline+1+len(items), page.OpenHandles(), "Each element holds a remote object")
+		s.Equal(browserBaseline+1+len(items), s.browser.OpenHandles())
+
+		s.Require().NoError(heading.Release())
+		for _, item := range items {
+			s.Require().NoError(item.Release())
+		}
+
+		s.Equal(pageBaseline, page.OpenHandles(), "Released elements are no longer counted")
+		s.Equal(browserBaseline, s.browser.OpenHandles())
+	})
+
+	s.Run("closing the page releases its elements", func() {
+		other, err := s.browser.NewPage()
+		s.Require().NoError(err)
+		s.Require().NoError(other.SetContent(`<html><body><p>one</p><p>two</p></body></html>`))
+
+		_, err = other.Elements("p")
+		s.Require().NoError(err)
+		s.Equal(2, other.OpenHandles())
+
+		s.Require().NoError(other.Close())
+		s.Equal(0, other.OpenHandles())
+		s.Equal(browserBaseline, s.browser.OpenHandles())
+	})
+
+	s.Run("temporary waits release their listener", func() {
+		_, err := page.WaitForConsoleMessage("never-logged", 100*time.Millisecond)
+		s.Require().Error(err)
+
+		s.Eventually(func() bool {
+			return page.OpenHandles() == pageBaseline
+		}, 2*time.Second, 50*time.Millisecond)
+	})
+}
+
+func TestTrackHandle(t *testing.T) {
+	browser := &Browser{}
+	first := &Page{browser: browser}
+	second := &Page{browser: browser}
+
+	releaseFirst := first.trackHandle()
+	releaseSecond := second.trackHandle()
+	assert.Equal(t, 1, first.OpenHandles())
+	assert.Equal(t, 2, browser.OpenHandles())
+
+	releaseFirst()
+	releaseFirst() // releasing twice must not double count
+	assert.Equal(t, 0, first.OpenHandles())
+	assert.Equal(t, 1, browser.OpenHandles())
+
+	releaseSecond()
+	assert.Equal(t, 0, browser.OpenHandles())
+}
+
+func TestTrackObject(t *testing.T) {
+	browser := &Browser{}
+	page := &Page{browser: browser}
+
+	page.trackObject("1")
+	page.trackObject("1") // copies of an element share its remote object
+	page.trackObject("2")
+	page.trackObject("") // values returned by value hold no remote object
+	assert.Equal(t, 2, page.OpenHandles())
+	assert.Equal(t, 2, browser.OpenHandles())
+
+	page.untrackObject("1")
+	page.untrackObject("1")
+	assert.Equal(t, 1, page.OpenHandles())
+
+	page.mu.Lock()
+	page.closed = true
+	page.releaseObjects()
+	page.mu.Unlock()
+	assert.Equal(t, 0, page.OpenHandles())
+	assert.Equal(t, 0, browser.OpenHandles())
+
+	page.trackObject("3")
+	assert.Equal(t, 0, page.OpenHandles(), "Closed pages don't track new objects")
+}
+
+// Run the handles test suite
+func TestHandlesSuite(t *testing.T) {
+	suite.Run(t, new(HandlesTestSuite))
+}
@@ -0,0 +1,234 @@
+package rodwer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-rod/rod/lib/input"
+)
+
+// Shortcut is a key combination such as "Control+A", modifiers first
+type Shortcut string
+
+// Common shortcuts, Control is sent as Meta on macOS
+const (
+	ShortcutSelectAll Shortcut = "Control+A"
+	ShortcutCopy      Shortcut = "Control+C"
+	ShortcutCut       Shortcut = "Control+X"
+	ShortcutPaste     Shortcut = "Control+V"
+	ShortcutUndo      Shortcut = "Control+Z"
+	ShortcutRedo      Shortcut = "Control+Shift+Z"
+)
+
+// KeyModifier is a modifier key held during a mouse action
+type KeyModifier string
+
+// Modifier keys accepted by HoverOptions
+const (
+	KeyModifierControl KeyModifier = "Control"
+	KeyModifierShift   KeyModifier = "Shift"
+	KeyModifierAlt     KeyModifier = "Alt"
+	KeyModifierMeta    KeyModifier = "Meta"
+)
+
+// shortcutModifiers maps modifier names to their keys
+var shortcutModifiers = map[string]input.Key{
+	"Control": input.ControlLeft,
+	"Meta":    input.MetaLeft,
+	"Shift":   input.ShiftLeft,
+	"Alt":     input.AltLeft,
+}
+
+// namedKeys maps the key names accepted by Element.Press to their keys
+var namedKeys = map[string]input.Key{
+	"Enter":      input.Enter,
+	"Tab":        input.Tab,
+	"Escape":     input.Escape,
+	"Backspace":  input.Backspace,
+	"Delete":     input.Delete,
+	"Space":      input.Space,
+	"ArrowUp":    input.ArrowUp,
+	"ArrowDown":  input.ArrowDown,
+	"ArrowLeft":  input.ArrowLeft,
+	"ArrowRight": input.ArrowRight,
+	"Home":       input.Home,
+	"End":        input.End,
+	"PageUp":     input.PageUp,
+	"PageDown":   input.PageDown,
+	"Insert":     input.Insert,
+}
+
+// Press focuses the element and presses keys in order. Keys are names such as "Enter", "Tab",
+// "Escape" or "ArrowDown", or single characters.
+func (e Element) Press(keys ...string) error {
+	if e.element == nil {
+		return fmt.Errorf("element is nil")
+	}
+
+	inputKeys := make([]input.Key, 0, len(keys))
+	for _, name := range keys {
+		key, err := keyByName(name)
+		if err != nil {
+			return err
+		}
+		inputKeys = append(inputKeys, key)
+	}
+
+	if err := e.element.Type(inputKeys...); err != nil {
+		return fmt.Errorf("failed to press %s: %w", strings.Join(keys, ", "), err)
+	}
+
+	return nil
+}
+
+// PressKey presses keys in order on the page's focused element. Each key is a name such as "Enter" or
+// "ArrowDown", a single character, or a combination with modifiers such as "Control+a" or "Shift+Tab".
+func (p *Page) PressKey(keys ...string) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	page := p.page.Context(p.ctx)
+	for _, combo := range keys {
+		modifiers, key, err := parseKeyCombo(combo, false)
+		if err != nil {
+			return err
+		}
+
+		if err := page.KeyActions().Press(modifiers...).Type(key).Do(); err != nil {
+			return fmt.Errorf("failed to press %s: %w", combo, err)
+		}
+	}
+
+	return nil
+}
+
+// TypeText types text on the page's focused element. Characters on a US keyboard are sent as key presses,
+// so key handlers fire, other characters are inserted as text.
+func (p *Page) TypeText(text string) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	page := p.page.Context(p.ctx)
+	for _, r := range text {
+		var err error
+		if key := input.Key(r); isKnownKey(key) {
+			err = page.Keyboard.Type(key)
+		} else {
+			err = page.InsertText(string(r))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to type %q: %w", r, err)
+		}
+	}
+
+	return nil
+}
+
+// parseKeyCombo splits a combination such as "Control+Shift+a" into its modifier keys and final key.
+// With mac set, Control is replaced with Meta.
+func parseKeyCombo(combo string, mac bool) ([]input.Key, input.Key, error) {
+	if combo == "+" {
+		return nil, input.Key('+'), nil
+	}
+
+	parts := strings.Split(combo, "+")
+	key, err := keyByName(parts[len(parts)-1])
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid key combination %q: %w", combo, err)
+	}
+
+	modifiers := make([]input.Key, 0, len(parts)-1)
+	for _, name := range parts[:len(parts)-1] {
+		if mac && name == "Control" {
+			name = "Meta"
+		}
+		modifier, ok := shortcutModifiers[name]
+		if !ok {
+			return nil, 0, fmt.Errorf("invalid key combination %q: unknown modifier %s", combo, name)
+		}
+		modifiers = append(modifiers, modifier)
+	}
+
+	return modifiers, key, nil
+}
+
+// keyByName resolves a name from namedKeys or a single character to its key
+func keyByName(name string) (input.Key, error) {
+	if key, ok := namedKeys[name]; ok {
+		return key, nil
+	}
+
+	if runes := []rune(name); len(runes) == 1 && isKnownKey(input.Key(runes[0])) {
+		return input.Key(runes[0]), nil
+	}
+
+	return 0, fmt.Errorf("unknown key %q", name)
+}
+
+// isKnownKey reports whether rod has a key definition for key, whose Info panics otherwise
+func isKnownKey(key input.Key) (known bool) {
+	defer func() {
+		if recover() != nil {
+			known = false
+		}
+	}()
+
+	_ = key.Info()
+	return true
+}
+
+// PressShortcut presses shortcut on the focused element.
+// On macOS, detected from the page's user agent, Control is replaced with Meta.
+func (p *Page) PressShortcut(shortcut Shortcut) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	page := p.page.Context(p.ctx)
+
+	res, err := page.Eval(`() => navigator.userAgent`)
+	if err != nil {
+		return fmt.Errorf("failed to read user agent: %w", err)
+	}
+
+	modifiers, key, err := parseShortcut(shortcut, isMacUserAgent(res.Value.Str()))
+	if err != nil {
+		return err
+	}
+
+	if err := page.KeyActions().Press(modifiers...).Type(key).Do(); err != nil {
+		return fmt.Errorf("failed to press %s: %w", shortcut, err)
+	}
+
+	return nil
+}
+
+// parseShortcut checks that shortcut ends with a single character and splits it like parseKeyCombo
+func parseShortcut(shortcut Shortcut, mac bool) ([]input.Key, input.Key, error) {
+	combo := string(shortcut)
+	last := combo[strings.LastIndex(combo, "+")+1:]
+	if len([]rune(last)) != 1 {
+		return nil, 0, fmt.Errorf("invalid shortcut %q: last key must be a single character", shortcut)
+	}
+
+	return parseKeyCombo(combo[:len(combo)-len(last)]+strings.ToLower(last), mac)
+}
+
+// isMacUserAgent reports whether userAgent belongs to a macOS browser
+func isMacUserAgent(userAgent string) bool {
+	return strings.Contains(userAgent, "Macintosh") || strings.Contains(userAgent, "Mac OS X")
+}
@@ -0,0 +1,199 @@
+package rodwer
+
+import (
+	"testing"
+
+	"github.com/go-rod/rod/lib/input"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// KeyboardTestSuite covers keyboard shortcuts
+type KeyboardTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *KeyboardTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *KeyboardTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *KeyboardTestSuite) TestPressShortcut() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body><textarea id="text">hello shortcuts</textarea></body></html>`)
+	s.Require().NoError(err)
+
+	textarea, err := page.Element("#text")
+	s.Require().NoError(err)
+	s.Require().NoError(textarea.Click())
+
+	s.Require().NoError(page.PressShortcut(ShortcutSelectAll))
+
+	selection, err := page.Evaluate(`() => {
+		const el = document.getElementById('text');
+		return [el.selectionStart, el.selectionEnd];
+	}`)
+	s.Require().NoError(err)
+	s.Equal([]interface{}{0.0, float64(len("hello shortcuts"))}, selection)
+
+	s.Run("invalid shortcut", func() {
+		s.Error(page.PressShortcut("Hyper+A"))
+	})
+}
+
+func (s *KeyboardTestSuite) TestPress() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<form id="search" onsubmit="event.preventDefault(); document.getElementById('result').textContent = 'searched ' + this.q.value">
+			<input id="q" name="q" onkeydown="if (event.key === 'Escape') this.value = ''">
+		</form>
+		<div id="result"></div>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	input, err := page.Element("#q")
+	s.Require().NoError(err)
+
+	s.Require().NoError(input.Type("rodwer"))
+	s.Require().NoError(input.Press("Enter"))
+
+	result, err := page.Element("#result")
+	s.Require().NoError(err)
+	text, err := result.Text()
+	s.Require().NoError(err)
+	s.Equal("searched rodwer", text, "Enter should submit the form")
+
+	s.Run("keys are pressed in order", func() {
+		s.Require().NoError(input.Press("Escape", "o", "k", "ArrowLeft", "Backspace"))
+
+		value, err := input.Value()
+		s.Require().NoError(err)
+		s.Equal("k", value)
+	})
+
+	s.Run("unknown key", func() {
+		err := input.Press("Hyper")
+		s.Require().Error(err)
+		s.Contains(err.Error(), "Hyper")
+	})
+}
+
+func (s *KeyboardTestSuite) TestPageKeyboard() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body>
+		<div id="editor" contenteditable="true"></div>
+		<script>
+			window.keys = [];
+			document.addEventListener('keydown', e => window.keys.push(e.key));
+		</script>
+	</body></html>`)
+	s.Require().NoError(err)
+
+	_, err = page.Evaluate(`() => document.getElementById('editor').focus()`)
+	s.Require().NoError(err)
+
+	s.Require().NoError(page.TypeText("Hi café"))
+
+	text, err := page.Evaluate(`() => document.getElementById('editor').textContent`)
+	s.Require().NoError(err)
+	s.Equal("Hi café", text)
+
+	keys, err := page.Evaluate(`() => window.keys.join('')`)
+	s.Require().NoError(err)
+	s.Equal("Hi caf", keys, "Keyboard characters fire keydown, others are inserted")
+
+	selected, err := page.Evaluate(`() => window.getSelection().toString()`)
+	s.Require().NoError(err)
+	s.Empty(selected)
+
+	s.Require().NoError(page.PressKey("Control+a"))
+
+	selected, err = page.Evaluate(`() => window.getSelection().toString()`)
+	s.Require().NoError(err)
+	s.Equal("Hi café", selected, "Control+a should select the editor content")
+
+	s.Run("named keys", func() {
+		s.Require().NoError(page.PressKey("Backspace"))
+
+		text, err := page.Evaluate(`() => document.getElementById('editor').textContent`)
+		s.Require().NoError(err)
+		s.Empty(text)
+	})
+
+	s.Run("invalid combination", func() {
+		s.Error(page.PressKey("Hyper+a"))
+		s.Error(page.PressKey("Control+Nope"))
+	})
+}
+
+func TestParseKeyCombo(t *testing.T) {
+	modifiers, key, err := parseKeyCombo("Control+Shift+Tab", false)
+	require.NoError(t, err)
+	assert.Equal(t, []input.Key{input.ControlLeft, input.ShiftLeft}, modifiers)
+	assert.Equal(t, input.Tab, key)
+
+	modifiers, key, err = parseKeyCombo("a", false)
+	require.NoError(t, err)
+	assert.Empty(t, modifiers)
+	assert.Equal(t, input.KeyA, key)
+
+	_, key, err = parseKeyCombo("+", false)
+	require.NoError(t, err)
+	assert.Equal(t, input.Key('+'), key)
+
+	_, _, err = parseKeyCombo("Control+é", false)
+	assert.Error(t, err, "Keys rod can't dispatch are rejected")
+
+	_, _, err = parseKeyCombo("Super+a", false)
+	assert.Error(t, err)
+
+	modifiers, _, err = parseKeyCombo("Control+Shift+a", true)
+	require.NoError(t, err)
+	assert.Equal(t, []input.Key{input.MetaLeft, input.ShiftLeft}, modifiers, "Control becomes Meta on macOS")
+}
+
+func TestParseShortcut(t *testing.T) {
+	modifiers, key, err := parseShortcut(ShortcutSelectAll, false)
+	require.NoError(t, err)
+	assert.Equal(t, []input.Key{input.ControlLeft}, modifiers)
+	assert.Equal(t, input.KeyA, key)
+
+	modifiers, key, err = parseShortcut(ShortcutRedo, true)
+	require.NoError(t, err)
+	assert.Equal(t, []input.Key{input.MetaLeft, input.ShiftLeft}, modifiers, "Control becomes Meta on macOS")
+	assert.Equal(t, input.KeyZ, key)
+
+	_, _, err = parseShortcut("Control+Enter", false)
+	assert.Error(t, err)
+
+	_, _, err = parseShortcut("Control+é", false)
+	assert.ErrorContains(t, err, "unknown key", "Keys rod doesn't define are rejected instead of panicking")
+
+	assert.True(t, isMacUserAgent("Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36"))
+	assert.False(t, isMacUserAgent("Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36"))
+}
+
+// Run the keyboard test suite
+func TestKeyboardSuite(t *testing.T) {
+	suite.Run(t, new(KeyboardTestSuite))
+}
@@ -0,0 +1,83 @@
+package rodwer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// HeapUsage returns the bytes used by the page's JavaScript heap
+func (p *Page) HeapUsage() (int64, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return 0, fmt.Errorf("page is closed")
+	}
+
+	res, err := proto.RuntimeGetHeapUsage{}.Call(p.page.Context(p.ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get heap usage: %w", err)
+	}
+
+	return int64(res.UsedSize), nil
+}
+
+// TakeHeapSnapshot returns a snapshot of the page's JavaScript heap in the .heapsnapshot JSON format
+// understood by the DevTools Memory panel
+func (p *Page) TakeHeapSnapshot() ([]byte, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	defer p.browser.trackOp()()
+
+	ctx, cancel := context.WithCancel(p.ctx)
+	defer cancel()
+	page := p.page.Context(ctx)
+
+	// The snapshot arrives as chunk events while the command runs
+	var mu sync.Mutex
+	var snapshot bytes.Buffer
+	wait := page.EachEvent(func(e *proto.HeapProfilerAddHeapSnapshotChunk) {
+		mu.Lock()
+		snapshot.WriteString(e.Chunk)
+		mu.Unlock()
+	})
+	p.listen(wait)
+
+	if err := (proto.HeapProfilerTakeHeapSnapshot{}).Call(page); err != nil {
+		return nil, fmt.Errorf("failed to take heap snapshot: %w", err)
+	}
+
+	// Chunk events may still be in flight when the command returns
+	deadline := time.Now().Add(HeapSnapshotTimeout)
+	for {
+		mu.Lock()
+		var data []byte
+		if json.Valid(snapshot.Bytes()) {
+			data = append(data, snapshot.Bytes()...)
+		}
+		mu.Unlock()
+
+		if data != nil {
+			return data, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timeout waiting for heap snapshot chunks after %v", HeapSnapshotTimeout)
+		}
+
+		time.Sleep(ElementPollInterval)
+	}
+}
@@ -0,0 +1,82 @@
+package rodwer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// MemoryTestSuite covers heap usage and snapshots
+type MemoryTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *MemoryTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *MemoryTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *MemoryTestSuite) TestHeapUsage() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.SetContent(`<html><body><h1>Memory</h1></body></html>`))
+
+	before, err := page.HeapUsage()
+	s.Require().NoError(err)
+	s.Positive(before)
+
+	// A global keeps every array reachable, like a leak would
+	_, err = page.Evaluate(`() => {
+		window.leaked = [];
+		for (let i = 0; i < 50; i++) {
+			window.leaked.push(new Array(100000).fill(i));
+		}
+	}`)
+	s.Require().NoError(err)
+
+	after, err := page.HeapUsage()
+	s.Require().NoError(err)
+	s.Greater(after-before, int64(10<<20), "Leaking 50 arrays of 100k numbers should grow the heap by more than 10MB")
+}
+
+func (s *MemoryTestSuite) TestTakeHeapSnapshot() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.SetContent(`<html><body><script>
+		class LeakyWidget {}
+		window.widgets = Array.from({ length: 3 }, () => new LeakyWidget());
+	</script></body></html>`))
+
+	data, err := page.TakeHeapSnapshot()
+	s.Require().NoError(err)
+
+	var snapshot struct {
+		Snapshot struct {
+			NodeCount int `json:"node_count"`
+		} `json:"snapshot"`
+		Strings []string `json:"strings"`
+	}
+	s.Require().NoError(json.Unmarshal(data, &snapshot))
+	s.Positive(snapshot.Snapshot.NodeCount)
+	s.Contains(snapshot.Strings, "LeakyWidget", "Snapshot should contain the page's classes")
+}
+
+// Run the memory test suite
+func TestMemorySuite(t *testing.T) {
+	suite.Run(t, new(MemoryTestSuite))
+}
@@ -0,0 +1,154 @@
+package rodwer
+
+import (
+	"fmt"
+	"time"
+)
+
+// speechSynthesisMockScript replaces window.speechSynthesis with a recorder of spoken texts
+const speechSynthesisMockScript = `(() => {
+	const spoken = [];
+	const mock = {
+		speaking: false,
+		pending: false,
+		paused: false,
+		speak(utterance) {
+			spoken.push(typeof utterance === 'string' ? utterance : utterance.text);
+			if (utterance && typeof utterance.onend === 'function') {
+				setTimeout(() => utterance.onend(new Event('end')), 0);
+			}
+		},
+		cancel() {},
+		pause() {},
+		resume() {},
+		getVoices() { return []; },
+		addEventListener() {},
+		removeEventListener() {},
+	};
+	Object.defineProperty(window, 'speechSynthesis', { value: mock, configurable: true });
+	Object.defineProperty(window, '__rodwerSpokenTexts', { value: spoken, configurable: true });
+})();`
+
+// SpeechMock records text passed to the page's speechSynthesis.speak
+type SpeechMock struct {
+	page *Page
+}
+
+// MockSpeechSynthesis replaces window.speechSynthesis on the current and every future document of the page
+func (p *Page) MockSpeechSynthesis() (*SpeechMock, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	if _, err := p.page.EvalOnNewDocument(speechSynthesisMockScript); err != nil {
+		return nil, fmt.Errorf("failed to install speech synthesis mock: %w", err)
+	}
+
+	// The init script only runs for new documents, patch the current one too
+	if _, err := p.page.Eval(`() => { ` + speechSynthesisMockScript + ` }`); err != nil {
+		return nil, fmt.Errorf("failed to install speech synthesis mock: %w", err)
+	}
+
+	return &SpeechMock{page: p}, nil
+}
+
+// SpokenTexts returns the texts spoken on the current document, in order
+func (m *SpeechMock) SpokenTexts() []string {
+	res, err := m.page.page.Eval(`() => window.__rodwerSpokenTexts || []`)
+	if err != nil {
+		return nil
+	}
+
+	texts := make([]string, 0, len(res.Value.Arr()))
+	for _, text := range res.Value.Arr() {
+		texts = append(texts, text.Str())
+	}
+
+	return texts
+}
+
+// mockDateScript replaces window.Date with a subclass frozen at ms milliseconds since the epoch.
+// The original Date is kept so mocking again doesn't stack subclasses.
+const mockDateScript = `(() => {
+	const OriginalDate = window.__rodwerOriginalDate || Date;
+	const now = %d;
+	class MockDate extends OriginalDate {
+		constructor(...args) {
+			if (args.length === 0) super(now); else super(...args);
+		}
+		static now() { return now; }
+	}
+	// Date() called without new returns the current time as a string
+	const mock = new Proxy(MockDate, { apply: () => new OriginalDate(now).toString() });
+	Object.defineProperty(window, '__rodwerOriginalDate', { value: OriginalDate, configurable: true });
+	window.Date = mock;
+})();`
+
+// dateMock is the frozen time installed by MockDate
+type dateMock struct {
+	now    time.Time
+	remove func() error // removes the init script
+}
+
+// MockDate freezes Date.now() and new Date() at t on the current and every future document of the page
+func (p *Page) MockDate(t time.Time) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	return p.installDateMock(t)
+}
+
+// AdvanceDate moves the time frozen by MockDate forward by d
+func (p *Page) AdvanceDate(d time.Duration) error {
+	p.mu.RLock()
+	closed := p.closed
+	mock := p.dateMock
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	if mock == nil {
+		return fmt.Errorf("date is not mocked, call MockDate first")
+	}
+
+	return p.installDateMock(mock.now.Add(d))
+}
+
+// installDateMock replaces the date mock init script and patches the current document
+func (p *Page) installDateMock(t time.Time) error {
+	script := fmt.Sprintf(mockDateScript, t.UnixMilli())
+	page := p.page.Context(p.ctx)
+
+	remove, err := page.EvalOnNewDocument(script)
+	if err != nil {
+		return fmt.Errorf("failed to install date mock: %w", err)
+	}
+
+	// The init script only runs for new documents, patch the current one too
+	if _, err := page.Eval(`() => { ` + script + ` }`); err != nil {
+		_ = remove()
+		return fmt.Errorf("failed to install date mock: %w", err)
+	}
+
+	p.mu.Lock()
+	previous := p.dateMock
+	p.dateMock = &dateMock{now: t, remove: remove}
+	p.mu.Unlock()
+
+	if previous != nil {
+		_ = previous.remove()
+	}
+
+	return nil
+}
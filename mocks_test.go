@@ -0,0 +1,110 @@
+package rodwer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// MockTestSuite covers browser API mocks
+type MockTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *MockTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *MockTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *MockTestSuite) TestMockSpeechSynthesis() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	mock, err := page.MockSpeechSynthesis()
+	s.Require().NoError(err)
+	s.Empty(mock.SpokenTexts())
+
+	html := `
+	<html>
+	<body>
+		<button id="speak" onclick="speechSynthesis.speak(new SpeechSynthesisUtterance('Button pressed'))">Speak</button>
+		<script>
+			speechSynthesis.speak(new SpeechSynthesisUtterance('Welcome'));
+		</script>
+	</body>
+	</html>`
+
+	err = page.Navigate("data:text/html," + html)
+	s.Require().NoError(err)
+
+	s.Equal([]string{"Welcome"}, mock.SpokenTexts(), "Speech during page load should be captured")
+
+	button, err := page.Element("#speak")
+	s.Require().NoError(err)
+	s.Require().NoError(button.Click())
+
+	s.Equal([]string{"Welcome", "Button pressed"}, mock.SpokenTexts())
+}
+
+func (s *MockTestSuite) TestMockDate() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Error(page.AdvanceDate(time.Hour), "Advancing needs a mocked date")
+
+	frozen := time.Date(2024, time.February, 29, 12, 30, 0, 0, time.UTC)
+	s.Require().NoError(page.MockDate(frozen))
+
+	now := func() (int64, int64) {
+		res, err := page.EvalJS(`[Date.now(), new Date().getTime()]`)
+		s.Require().NoError(err)
+		var times []int64
+		s.Require().NoError(res.JSON(&times))
+		return times[0], times[1]
+	}
+
+	dateNow, newDate := now()
+	s.Equal(frozen.UnixMilli(), dateNow)
+	s.Equal(frozen.UnixMilli(), newDate)
+
+	s.Run("explicit dates are untouched", func() {
+		res, err := page.EvalJS(`new Date(Date.UTC(2000, 0, 1)).toISOString()`)
+		s.Require().NoError(err)
+		s.Equal("2000-01-01T00:00:00.000Z", res.String())
+
+		res, err = page.EvalJS(`new Date() instanceof Date`)
+		s.Require().NoError(err)
+		s.True(res.Bool())
+	})
+
+	s.Require().NoError(page.AdvanceDate(24 * time.Hour))
+
+	dateNow, newDate = now()
+	s.Equal(frozen.Add(24*time.Hour).UnixMilli(), dateNow)
+	s.Equal(frozen.Add(24*time.Hour).UnixMilli(), newDate)
+
+	s.Run("mock survives navigation", func() {
+		s.Require().NoError(page.Navigate("data:text/html,<html><body>next</body></html>"))
+
+		dateNow, _ := now()
+		s.Equal(frozen.Add(24*time.Hour).UnixMilli(), dateNow)
+	})
+}
+
+// Run the mock test suite
+func TestMockSuite(t *testing.T) {
+	suite.Run(t, new(MockTestSuite))
+}
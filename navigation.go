@@ -0,0 +1,364 @@
+package rodwer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// LoadState is a document loading milestone that can be waited for
+type LoadState string
+
+// Load states, named like Playwright's
+const (
+	LoadStateLoad             LoadState = "load"             // the load event fired
+	LoadStateDOMContentLoaded LoadState = "domcontentloaded" // the document was parsed
+	LoadStateNetworkIdle      LoadState = "networkidle"      // no requests for NetworkIdleTime
+)
+
+// WaitForLoadState waits until the current document has reached state
+func (p *Page) WaitForLoadState(state LoadState, timeout time.Duration) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return ErrPageClosed
+	}
+
+	if err := validateLoadState(state); err != nil {
+		return err
+	}
+
+	defer p.browser.trackOp()()
+
+	ctx, cancel := context.WithTimeout(p.ctx, timeout)
+	defer cancel()
+
+	if err := p.waitForLoadState(p.page.Context(ctx), state); err != nil {
+		return fmt.Errorf("failed to wait for %s: %w", state, err)
+	}
+
+	return nil
+}
+
+// lifecycleCommit is the lifecycle event Chrome fires once a navigation has committed to the new document
+const lifecycleCommit proto.PageLifecycleEventName = "commit"
+
+// WaitForNavigationOptions configures WaitForNavigation
+type WaitForNavigationOptions struct {
+	URL       string    // only match navigations to this URL, accepts the same patterns as WaitForURL
+	WaitUntil LoadState // defaults to LoadStateLoad
+}
+
+// WaitForNavigation waits for the next main frame navigation to commit and reach the requested load state.
+// Start it before the navigation can commit, e.g. before a delayed redirect fires.
+func (p *Page) WaitForNavigation(timeout time.Duration, opts ...WaitForNavigationOptions) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return ErrPageClosed
+	}
+
+	var options WaitForNavigationOptions
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+	if options.WaitUntil == "" {
+		options.WaitUntil = LoadStateLoad
+	}
+
+	if err := validateLoadState(options.WaitUntil); err != nil {
+		return err
+	}
+
+	var match func(string) bool
+	if options.URL != "" {
+		var err error
+		if match, err = urlMatcher(options.URL); err != nil {
+			return err
+		}
+	}
+
+	defer p.browser.trackOp()()
+
+	ctx, cancel := context.WithTimeout(p.ctx, timeout)
+	defer cancel()
+	page := p.page.Context(ctx)
+
+	if err := (proto.PageSetLifecycleEventsEnabled{Enabled: true}).Call(page); err != nil {
+		return fmt.Errorf("failed to enable lifecycle events: %w", err)
+	}
+	defer func() { _ = proto.PageSetLifecycleEventsEnabled{Enabled: false}.Call(p.page) }()
+
+	page.EachEvent(func(e *proto.PageLifecycleEvent) bool {
+		if e.FrameID != page.FrameID || e.Name != lifecycleCommit {
+			return false
+		}
+		return match == nil || match(p.URL())
+	})()
+
+	if ctx.Err() != nil {
+		if options.URL != "" {
+			return fmt.Errorf("timeout waiting for navigation to %q: %w", options.URL, ctx.Err())
+		}
+		return fmt.Errorf("timeout waiting for navigation: %w", ctx.Err())
+	}
+
+	if err := p.waitForLoadState(page, options.WaitUntil); err != nil {
+		return fmt.Errorf("failed to wait for %s after navigation: %w", options.WaitUntil, err)
+	}
+
+	return nil
+}
+
+// validateLoadState rejects unknown load states
+func validateLoadState(state LoadState) error {
+	switch state {
+	case LoadStateLoad, LoadStateDOMContentLoaded, LoadStateNetworkIdle:
+		return nil
+	default:
+		return fmt.Errorf("unsupported load state: %s", state)
+	}
+}
+
+// waitForLoadState blocks until page, a context bound copy of p's page, has reached state
+func (p *Page) waitForLoadState(page *rod.Page, state LoadState) error {
+	return p.watchLoadState(page, state)()
+}
+
+// watchLoadState starts watching for state and returns a function that blocks until it is reached.
+// Call it before triggering a load so no request is missed, page's context must be cancelled afterwards.
+func (p *Page) watchLoadState(page *rod.Page, state LoadState) func() error {
+	switch state {
+	case LoadStateDOMContentLoaded:
+		return func() error {
+			return page.Wait(rod.Eval(`() => document.readyState !== 'loading'`))
+		}
+	case LoadStateNetworkIdle:
+		waitIdle := p.watchNetworkIdle(page)
+		return func() error {
+			if err := waitIdle(); err != nil {
+				return err
+			}
+			return page.WaitLoad()
+		}
+	default:
+		return page.WaitLoad
+	}
+}
+
+// watchNetworkIdle starts counting in-flight requests on page, a context bound copy of p's page,
+// and returns a function that blocks until none have been active for NetworkIdleTime
+func (p *Page) watchNetworkIdle(page *rod.Page) func() error {
+	ctx := page.GetContext()
+	activity := make(chan int)
+	inflight := map[proto.NetworkRequestID]bool{}
+
+	// The handlers run on the single event goroutine, so inflight needs no lock
+	report := func() {
+		select {
+		case activity <- len(inflight):
+		case <-ctx.Done():
+		}
+	}
+
+	wait := page.EachEvent(func(e *proto.NetworkRequestWillBeSent) {
+		inflight[e.RequestID] = true
+		report()
+	}, func(e *proto.NetworkLoadingFinished) {
+		delete(inflight, e.RequestID)
+		report()
+	}, func(e *proto.NetworkLoadingFailed) {
+		delete(inflight, e.RequestID)
+		report()
+	})
+	p.listenNetwork(wait)
+
+	return func() error {
+		idle := time.NewTimer(NetworkIdleTime)
+		defer idle.Stop()
+
+		for {
+			select {
+			case n := <-activity:
+				idle.Stop()
+				if n == 0 {
+					idle.Reset(NetworkIdleTime)
+				}
+			case <-idle.C:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// ReloadOptions configures page reload
+type ReloadOptions struct {
+	IgnoreCache bool // bypass the browser cache, like shift-refresh
+}
+
+// Reload reloads the current page and waits for it to load
+func (p *Page) Reload(options ...ReloadOptions) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return ErrPageClosed
+	}
+
+	defer p.browser.trackOp()()
+
+	var opts ReloadOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	page, cancel := p.page.Context(p.ctx).WithCancel()
+	defer cancel()
+
+	err := navigateAndWait(page, func() error {
+		return proto.PageReload{IgnoreCache: opts.IgnoreCache}.Call(page)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reload page: %w", err)
+	}
+
+	return nil
+}
+
+// GoBack navigates to the previous history entry and waits for it to load
+func (p *Page) GoBack() error {
+	return p.navigateHistory(-1)
+}
+
+// GoForward navigates to the next history entry and waits for it to load
+func (p *Page) GoForward() error {
+	return p.navigateHistory(1)
+}
+
+// navigateHistory moves offset entries through the page's navigation history
+func (p *Page) navigateHistory(offset int) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return ErrPageClosed
+	}
+
+	defer p.browser.trackOp()()
+
+	page, cancel := p.page.Context(p.ctx).WithCancel()
+	defer cancel()
+
+	entry, err := historyEntry(page, offset)
+	if err != nil {
+		return err
+	}
+
+	err = navigateAndWait(page, func() error {
+		return proto.PageNavigateToHistoryEntry{EntryID: entry.ID}.Call(page)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to navigate to history entry %s: %w", entry.URL, err)
+	}
+
+	return nil
+}
+
+// GoBackAndWait navigates to the previous history entry and waits for readySelector to appear on it
+func (p *Page) GoBackAndWait(readySelector string, timeout time.Duration) (Element, error) {
+	return p.navigateHistoryAndWait(-1, readySelector, timeout)
+}
+
+// GoForwardAndWait navigates to the next history entry and waits for readySelector to appear on it
+func (p *Page) GoForwardAndWait(readySelector string, timeout time.Duration) (Element, error) {
+	return p.navigateHistoryAndWait(1, readySelector, timeout)
+}
+
+// navigateHistoryAndWait moves offset entries through the history and polls for readySelector once the
+// entry is committed, so a selector present on both documents can't match the one being left.
+// Pages restored from the back/forward cache fire no load event, so only the commit is awaited.
+func (p *Page) navigateHistoryAndWait(offset int, readySelector string, timeout time.Duration) (Element, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return Element{}, ErrPageClosed
+	}
+
+	deadline := time.Now().Add(timeout)
+	ctx, cancel := context.WithDeadline(p.ctx, deadline)
+	defer cancel()
+
+	page := p.page.Context(ctx)
+
+	entry, err := historyEntry(page, offset)
+	if err != nil {
+		return Element{}, err
+	}
+
+	// Subscribe before navigating so the commit can't be missed
+	wait := page.EachEvent(func(e *proto.PageFrameNavigated) bool {
+		return e.Frame.ID == page.FrameID
+	}, func(e *proto.PageNavigatedWithinDocument) bool {
+		return e.FrameID == page.FrameID
+	})
+
+	if err := (proto.PageNavigateToHistoryEntry{EntryID: entry.ID}).Call(page); err != nil {
+		return Element{}, fmt.Errorf("failed to navigate to history entry %s: %w", entry.URL, err)
+	}
+
+	wait()
+	if err := ctx.Err(); err != nil {
+		return Element{}, fmt.Errorf("timeout waiting for history entry %s: %w", entry.URL, err)
+	}
+
+	return p.waitForElementAcrossNavigation(readySelector, time.Until(deadline))
+}
+
+// historyEntry returns the navigation history entry offset entries away from the current one
+func historyEntry(page *rod.Page, offset int) (*proto.PageNavigationEntry, error) {
+	history, err := proto.PageGetNavigationHistory{}.Call(page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get navigation history: %w", err)
+	}
+
+	index := history.CurrentIndex + offset
+	if index < 0 {
+		return nil, ErrNoPreviousPage
+	}
+	if index >= len(history.Entries) {
+		return nil, ErrNoNextPage
+	}
+
+	return history.Entries[index], nil
+}
+
+// navigateAndWait runs navigate and waits until the main frame has navigated and finished loading
+func navigateAndWait(page *rod.Page, navigate func() error) error {
+	// Subscribe before navigating so the event can't be missed
+	wait := page.EachEvent(func(e *proto.PageFrameNavigated) bool {
+		return e.Frame.ID == page.FrameID
+	}, func(e *proto.PageNavigatedWithinDocument) bool {
+		return e.FrameID == page.FrameID
+	})
+
+	if err := navigate(); err != nil {
+		return err
+	}
+
+	wait()
+
+	return page.WaitLoad()
+}
@@ -0,0 +1,390 @@
+package rodwer
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// NavigationTestSuite covers reload and history navigation
+type NavigationTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *NavigationTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *NavigationTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *NavigationTestSuite) TestReload() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	var mu sync.Mutex
+	var cacheControl []string
+	testServer.AddRoute("/reload", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		cacheControl = append(cacheControl, r.Header.Get("Cache-Control"))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body>
+			<div id="flag"></div>
+			<script>
+				document.addEventListener('DOMContentLoaded', function() {
+					document.getElementById('flag').textContent = localStorage.getItem('flag') || 'unset';
+				});
+			</script>
+		</body></html>`))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.Navigate(testServer.URL + "/reload")
+	s.Require().NoError(err)
+
+	flagText := func() string {
+		el, err := page.Element("#flag")
+		s.Require().NoError(err)
+		text, err := el.Text()
+		s.Require().NoError(err)
+		return text
+	}
+	s.Equal("unset", flagText())
+
+	s.Run("reload re-runs DOMContentLoaded handlers", func() {
+		_, err := page.page.Eval(`() => localStorage.setItem('flag', 'stored')`)
+		s.Require().NoError(err)
+
+		err = page.Reload()
+		s.Require().NoError(err)
+		s.Equal("stored", flagText())
+	})
+
+	s.Run("ignore cache bypasses the browser cache", func() {
+		err := page.Reload(ReloadOptions{IgnoreCache: true})
+		s.Require().NoError(err)
+		s.Equal("stored", flagText())
+
+		mu.Lock()
+		defer mu.Unlock()
+		s.Require().Len(cacheControl, 3)
+		s.Equal("no-cache", cacheControl[2])
+	})
+
+	s.Run("closed page", func() {
+		closedPage, err := s.browser.NewPage()
+		s.Require().NoError(err)
+		s.Require().NoError(closedPage.Close())
+
+		err = closedPage.Reload()
+		s.True(errors.Is(err, ErrPageClosed))
+	})
+}
+
+func (s *NavigationTestSuite) TestGoBackAndForward() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Run("fresh page has no history", func() {
+		s.True(errors.Is(page.GoBack(), ErrNoPreviousPage))
+		s.True(errors.Is(page.GoForward(), ErrNoNextPage))
+	})
+
+	heading := func() string {
+		el, err := page.Element("h1")
+		s.Require().NoError(err)
+		text, err := el.Text()
+		s.Require().NoError(err)
+		return text
+	}
+
+	s.Require().NoError(page.Navigate("data:text/html,<html><body><h1>First</h1></body></html>"))
+	s.Require().NoError(page.Navigate("data:text/html,<html><body><h1>Second</h1></body></html>"))
+
+	s.Run("back returns to the previous page", func() {
+		s.Require().NoError(page.GoBack())
+		s.Equal("First", heading())
+	})
+
+	s.Run("forward returns to the next page", func() {
+		s.Require().NoError(page.GoForward())
+		s.Equal("Second", heading())
+		s.True(errors.Is(page.GoForward(), ErrNoNextPage))
+	})
+
+	s.Run("closed page", func() {
+		closedPage, err := s.browser.NewPage()
+		s.Require().NoError(err)
+		s.Require().NoError(closedPage.Close())
+
+		s.True(errors.Is(closedPage.GoBack(), ErrPageClosed))
+		s.True(errors.Is(closedPage.GoForward(), ErrPageClosed))
+	})
+}
+
+func (s *NavigationTestSuite) TestWaitForLoadState() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/spa", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body>
+			<div id="data">loading</div>
+			<script>
+				window.addEventListener('load', function() {
+					setTimeout(function() {
+						fetch('/api/data').then(r => r.text()).then(t => { document.getElementById('data').textContent = t; });
+					}, 100);
+				});
+			</script>
+		</body></html>`))
+	})
+	testServer.AddRoute("/api/data", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(700 * time.Millisecond)
+		w.Write([]byte("loaded"))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL + "/spa"))
+
+	s.Run("load and domcontentloaded are already reached", func() {
+		s.NoError(page.WaitForLoadState(LoadStateLoad, time.Second))
+		s.NoError(page.WaitForLoadState(LoadStateDOMContentLoaded, time.Second))
+	})
+
+	s.Run("networkidle waits for requests started after load", func() {
+		s.Require().NoError(page.Navigate(testServer.URL + "/spa"))
+		s.Require().NoError(page.WaitForLoadState(LoadStateNetworkIdle, 5*time.Second))
+
+		el, err := page.Element("#data")
+		s.Require().NoError(err)
+		text, err := el.Text()
+		s.Require().NoError(err)
+		s.Equal("loaded", text)
+	})
+
+	s.Run("networkidle listener counts as an open handle", func() {
+		baseline := page.OpenHandles()
+		s.Require().NoError(page.Navigate(testServer.URL + "/spa"))
+
+		done := make(chan error, 1)
+		go func() { done <- page.WaitForLoadState(LoadStateNetworkIdle, 5*time.Second) }()
+
+		s.Eventually(func() bool { return page.OpenHandles() > baseline }, time.Second, 10*time.Millisecond)
+		s.Require().NoError(<-done)
+		s.Eventually(func() bool { return page.OpenHandles() == baseline }, 2*time.Second, 20*time.Millisecond)
+	})
+
+	s.Run("timeout", func() {
+		s.Require().NoError(page.Navigate(testServer.URL + "/spa"))
+		s.Error(page.WaitForLoadState(LoadStateNetworkIdle, 200*time.Millisecond))
+	})
+
+	s.Run("unsupported load state", func() {
+		s.Error(page.WaitForLoadState("commit", time.Second))
+	})
+
+	s.Run("closed page", func() {
+		closedPage, err := s.browser.NewPage()
+		s.Require().NoError(err)
+		s.Require().NoError(closedPage.Close())
+
+		s.True(errors.Is(closedPage.WaitForLoadState(LoadStateLoad, time.Second), ErrPageClosed))
+	})
+}
+
+func (s *NavigationTestSuite) TestWaitForNavigation() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/start", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body><h1>Start</h1></body></html>`))
+	})
+	testServer.AddRoute("/hop", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body><h1>Hop</h1><script>setTimeout(() => { location.href = '/target' }, 200)</script></body></html>`))
+	})
+	testServer.AddRoute("/target", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body><h1>Target</h1></body></html>`))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	redirect := func(path string) {
+		_, err := page.Evaluate(`(path) => { setTimeout(() => { location.href = path }, 200) }`, path)
+		s.Require().NoError(err)
+	}
+
+	heading := func() string {
+		el, err := page.Element("h1")
+		s.Require().NoError(err)
+		text, err := el.Text()
+		s.Require().NoError(err)
+		return text
+	}
+
+	s.Run("next navigation", func() {
+		s.Require().NoError(page.Navigate(testServer.URL + "/start"))
+		redirect("/target")
+
+		s.Require().NoError(page.WaitForNavigation(5 * time.Second))
+		s.Equal("Target", heading())
+	})
+
+	s.Run("navigations to other URLs are skipped", func() {
+		s.Require().NoError(page.Navigate(testServer.URL + "/start"))
+		redirect("/hop")
+
+		err := page.WaitForNavigation(5*time.Second, WaitForNavigationOptions{URL: "**/target", WaitUntil: LoadStateDOMContentLoaded})
+		s.Require().NoError(err)
+		s.Equal(testServer.URL+"/target", page.URL())
+	})
+
+	s.Run("timeout without navigation", func() {
+		err := page.WaitForNavigation(300 * time.Millisecond)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "timeout waiting for navigation")
+	})
+}
+
+func (s *NavigationTestSuite) TestGoBackAndWait() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/a", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body><div id="only-a">A</div></body></html>`))
+	})
+	testServer.AddRoute("/b", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body><div id="only-b">B</div></body></html>`))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL + "/a"))
+	s.Require().NoError(page.Navigate(testServer.URL + "/b"))
+
+	s.Run("back waits for an element of the previous page", func() {
+		el, err := page.GoBackAndWait("#only-a", 5*time.Second)
+		s.Require().NoError(err)
+		text, err := el.Text()
+		s.Require().NoError(err)
+		s.Equal("A", text)
+	})
+
+	s.Run("forward waits for an element of the next page", func() {
+		el, err := page.GoForwardAndWait("#only-b", 5*time.Second)
+		s.Require().NoError(err)
+		text, err := el.Text()
+		s.Require().NoError(err)
+		s.Equal("B", text)
+
+		_, err = page.GoForwardAndWait("#only-b", time.Second)
+		s.True(errors.Is(err, ErrNoNextPage))
+	})
+
+	s.Run("missing selector times out", func() {
+		_, err := page.GoBackAndWait("#missing", 500*time.Millisecond)
+		s.Error(err)
+	})
+}
+
+func (s *NavigationTestSuite) TestGoBackAndWaitSharedSelector() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	// The slow, uncacheable first page keeps the second document around while going back
+	testServer.AddRoute("/first", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("Cache-Control", "no-store")
+		w.Write([]byte(`<html><body><div id="app">first</div></body></html>`))
+	})
+	testServer.AddRoute("/second", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body><div id="app">second</div></body></html>`))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL + "/first"))
+	s.Require().NoError(page.Navigate(testServer.URL + "/second"))
+
+	el, err := page.GoBackAndWait("#app", 5*time.Second)
+	s.Require().NoError(err)
+	text, err := el.Text()
+	s.Require().NoError(err)
+	s.Equal("first", text, "The element should belong to the document navigated to")
+
+	el, err = page.GoForwardAndWait("#app", 5*time.Second)
+	s.Require().NoError(err)
+	text, err = el.Text()
+	s.Require().NoError(err)
+	s.Equal("second", text)
+}
+
+func (s *NavigationTestSuite) TestNavigationTimeout() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	release := make(chan struct{})
+	defer close(release)
+	testServer.AddRoute("/hang", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+
+	browser, err := NewBrowser(BrowserOptions{Headless: true, NoSandbox: true, NavigationTimeout: 500 * time.Millisecond})
+	s.Require().NoError(err)
+	defer browser.Close()
+
+	page, err := browser.NewPage()
+	s.Require().NoError(err)
+
+	start := time.Now()
+	err = page.Navigate(testServer.URL + "/hang")
+	s.Require().Error(err)
+	s.Contains(err.Error(), "timeout navigating")
+	s.Less(time.Since(start), 5*time.Second, "Navigation should give up after the browser's timeout")
+
+	s.Run("page is usable afterwards", func() {
+		s.Require().NoError(page.Navigate(testServer.URL + "/health"))
+	})
+}
+
+// Run the navigation test suite
+func TestNavigationSuite(t *testing.T) {
+	suite.Run(t, new(NavigationTestSuite))
+}
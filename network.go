@@ -0,0 +1,607 @@
+package rodwer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/ysmood/gson"
+)
+
+// InterceptedRequest describes a request paused by network interception
+type InterceptedRequest struct {
+	URL          string
+	Method       string
+	Headers      map[string]string
+	PostData     string
+	ResourceType string
+}
+
+// InterceptResponse is a mocked response used to fulfill an intercepted request
+type InterceptResponse struct {
+	Status  int
+	Headers map[string]string
+	Body    []byte
+}
+
+// NetworkInterceptHandler handles an intercepted request.
+// Returning nil continues the request unchanged, otherwise the request is fulfilled with the response.
+type NetworkInterceptHandler func(req *InterceptedRequest) *InterceptResponse
+
+// syntheticDocumentURL is used for intercepted documents when the page has no http(s) URL yet
+const syntheticDocumentURL = "http://rodwer.localhost/"
+
+// SetContentAndHeaders loads html as the page document, served with the given response headers and status code.
+// The next document request is intercepted via the Fetch domain and fulfilled with the provided response,
+// so the document behaves like one served by a real server.
+func (p *Page) SetContentAndHeaders(html string, headers map[string]string, statusCode int) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	defer p.browser.trackOp()()
+
+	if statusCode == 0 {
+		statusCode = 200
+	}
+
+	// Reuse the current URL so relative resources resolve as before
+	target := p.URL()
+	if !strings.HasPrefix(target, "http://") && !strings.HasPrefix(target, "https://") {
+		target = syntheticDocumentURL
+	}
+
+	ctx, cancel := context.WithTimeout(p.ctx, PageLoadTimeout)
+	defer cancel()
+	page := p.page.Context(ctx)
+
+	responseHeaders := make([]*proto.FetchHeaderEntry, 0, len(headers)+1)
+	hasContentType := false
+	for name, value := range headers {
+		if strings.EqualFold(name, "Content-Type") {
+			hasContentType = true
+		}
+		responseHeaders = append(responseHeaders, &proto.FetchHeaderEntry{Name: name, Value: value})
+	}
+	if !hasContentType {
+		responseHeaders = append(responseHeaders, &proto.FetchHeaderEntry{Name: "Content-Type", Value: "text/html; charset=utf-8"})
+	}
+
+	// Fulfill the first paused document request with our response
+	var fulfillErr error
+	var once sync.Once
+	fulfilled := make(chan struct{})
+	user := &fetchUser{
+		patterns: []*proto.FetchRequestPattern{{
+			URLPattern:   "*",
+			ResourceType: proto.NetworkResourceTypeDocument,
+			RequestStage: proto.FetchRequestStageRequest,
+		}},
+		take: func(_ *rod.Page, e *proto.FetchRequestPaused) bool {
+			if e.ResourceType != proto.NetworkResourceTypeDocument {
+				return false
+			}
+
+			took := false
+			once.Do(func() {
+				took = true
+				fulfillErr = proto.FetchFulfillRequest{
+					RequestID:       e.RequestID,
+					ResponseCode:    statusCode,
+					ResponseHeaders: responseHeaders,
+					Body:            []byte(html),
+				}.Call(page)
+				close(fulfilled)
+			})
+			return took
+		},
+	}
+
+	if err := p.acquireFetch(user); err != nil {
+		return err
+	}
+	defer func() { _ = p.releaseFetch(user) }()
+
+	if err := page.Navigate(target); err != nil {
+		return fmt.Errorf("failed to navigate to %s: %w", target, err)
+	}
+
+	select {
+	case <-fulfilled:
+	case <-ctx.Done():
+		return fmt.Errorf("timeout waiting for document request: %w", ctx.Err())
+	}
+
+	if fulfillErr != nil {
+		return fmt.Errorf("failed to fulfill document request: %w", fulfillErr)
+	}
+
+	if err := page.WaitLoad(); err != nil {
+		return fmt.Errorf("failed to wait for page load: %w", err)
+	}
+
+	return nil
+}
+
+// SetInterceptContentTypes intercepts requests whose Accept or Content-Type header matches one of the
+// MIME types and passes them to handler. Other requests continue untouched.
+// The returned function stops the interception. Requests the handler's types don't match are offered to
+// Route handlers and other interceptions on the page.
+func (p *Page) SetInterceptContentTypes(types []string, handler NetworkInterceptHandler) (func(), error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	if len(types) == 0 {
+		return nil, fmt.Errorf("at least one content type is required")
+	}
+
+	if handler == nil {
+		return nil, fmt.Errorf("intercept handler cannot be nil")
+	}
+
+	user := &fetchUser{
+		patterns: []*proto.FetchRequestPattern{{URLPattern: "*", RequestStage: proto.FetchRequestStageRequest}},
+		take: func(page *rod.Page, e *proto.FetchRequestPaused) bool {
+			req := newInterceptedRequest(e)
+			if !matchesContentType(req.Headers, types) {
+				return false
+			}
+			_ = respondToPausedRequest(page, e.RequestID, handler(req))
+			return true
+		},
+	}
+
+	if err := p.acquireFetch(user); err != nil {
+		return nil, err
+	}
+
+	stop := func() {
+		_ = p.releaseFetch(user)
+	}
+
+	return stop, nil
+}
+
+// networkPause buffers the requests paused by PauseNetwork
+type networkPause struct {
+	user     *fetchUser
+	mu       sync.Mutex
+	requests []*proto.FetchRequestPaused
+	resumed  bool
+}
+
+// PauseNetwork holds every request the page makes until ResumeNetwork is called.
+// Held requests are then offered to Route handlers and other interceptions on the page.
+func (p *Page) PauseNetwork() error {
+	p.mu.RLock()
+	closed := p.closed
+	paused := p.networkPause != nil
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	if paused {
+		return fmt.Errorf("network is already paused")
+	}
+
+	pause := &networkPause{}
+	pause.user = &fetchUser{
+		patterns: []*proto.FetchRequestPattern{{URLPattern: "*", RequestStage: proto.FetchRequestStageRequest}},
+		first:    true,
+		take: func(_ *rod.Page, e *proto.FetchRequestPaused) bool {
+			pause.mu.Lock()
+			defer pause.mu.Unlock()
+
+			// Requests racing with ResumeNetwork go to the other interceptions right away
+			if pause.resumed {
+				return false
+			}
+			pause.requests = append(pause.requests, e)
+			return true
+		},
+	}
+
+	if err := p.acquireFetch(pause.user); err != nil {
+		return fmt.Errorf("failed to pause network: %w", err)
+	}
+
+	p.mu.Lock()
+	closed = p.closed
+	paused = p.networkPause != nil
+	if !closed && !paused {
+		p.networkPause = pause
+	}
+	p.mu.Unlock()
+
+	if closed || paused {
+		// The page was closed or paused by a concurrent call while Fetch was enabled
+		_ = p.releaseFetch(pause.user)
+		if closed {
+			return fmt.Errorf("page is closed")
+		}
+		return fmt.Errorf("network is already paused")
+	}
+
+	return nil
+}
+
+// ResumeNetwork continues the requests held since PauseNetwork and stops holding new ones
+func (p *Page) ResumeNetwork() error {
+	p.mu.Lock()
+	closed := p.closed
+	pause := p.networkPause
+	p.networkPause = nil
+	p.mu.Unlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	if pause == nil {
+		return fmt.Errorf("network is not paused")
+	}
+
+	pause.mu.Lock()
+	pause.resumed = true
+	requests := pause.requests
+	pause.requests = nil
+	pause.mu.Unlock()
+
+	// Hand the held requests to the remaining interceptions before Fetch may be disabled
+	p.removeFetchUser(pause.user)
+
+	page := p.page.Context(p.ctx)
+	var errs []error
+	for _, e := range requests {
+		if err := p.dispatchFetch(page, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := p.syncFetch(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("failed to resume network: %w", err)
+	}
+
+	return nil
+}
+
+// SetExtraHTTPHeaders sends headers with every request the page makes, including after navigations.
+// Each call replaces the headers of the previous one, an empty map removes them.
+func (p *Page) SetExtraHTTPHeaders(headers map[string]string) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	networkHeaders := make(proto.NetworkHeaders, len(headers))
+	for name, value := range headers {
+		networkHeaders[name] = gson.New(value)
+	}
+
+	if err := applyExtraHeaders(p.page.Context(p.ctx), networkHeaders); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.extraHeaders = nil
+	if len(networkHeaders) > 0 {
+		p.extraHeaders = networkHeaders
+	}
+	p.mu.Unlock()
+
+	return nil
+}
+
+// listenNetwork is listen for wait functions subscribed to Network events. When such a listener enabled
+// the Network domain, rod disables it again once the listener ends, which drops extra HTTP headers in Chrome.
+func (p *Page) listenNetwork(wait func()) {
+	p.listen(func() {
+		wait()
+		p.restoreExtraHeaders()
+	})
+}
+
+// restoreExtraHeaders re-applies the headers of SetExtraHTTPHeaders when the Network domain was disabled
+func (p *Page) restoreExtraHeaders() {
+	p.mu.RLock()
+	closed := p.closed
+	headers := p.extraHeaders
+	p.mu.RUnlock()
+
+	if closed || headers == nil || p.page.LoadState(&proto.NetworkEnable{}) {
+		return
+	}
+
+	_ = applyExtraHeaders(p.page.Context(p.ctx), headers)
+}
+
+// applyExtraHeaders enables the Network domain, which extra headers need, and sets headers
+func applyExtraHeaders(page *rod.Page, headers proto.NetworkHeaders) error {
+	if err := (proto.NetworkEnable{}).Call(page); err != nil {
+		return fmt.Errorf("failed to enable network domain: %w", err)
+	}
+
+	if err := (proto.NetworkSetExtraHTTPHeaders{Headers: headers}).Call(page); err != nil {
+		return fmt.Errorf("failed to set extra HTTP headers: %w", err)
+	}
+
+	return nil
+}
+
+// FetchOptions configures FetchJSON
+type FetchOptions struct {
+	Method  string // defaults to GET
+	Body    string
+	Headers map[string]string
+}
+
+// FetchJSON calls fetch in the page context, so the page's cookies and origin apply, and unmarshals the JSON response into result
+func (p *Page) FetchJSON(url string, opts FetchOptions, result interface{}) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	defer p.browser.trackOp()()
+
+	method := opts.Method
+	if method == "" {
+		method = "GET"
+	}
+
+	res, err := p.page.Context(p.ctx).Eval(`async (url, method, headers, body) => {
+		const init = { method, headers: headers || {} };
+		if (body) init.body = body;
+		const res = await fetch(url, init);
+		return { status: res.status, ok: res.ok, text: await res.text() };
+	}`, url, method, opts.Headers, opts.Body)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+
+	if !res.Value.Get("ok").Bool() {
+		return fmt.Errorf("fetch %s returned status %d", url, res.Value.Get("status").Int())
+	}
+
+	// Decode the raw text so numbers land in result's own field types
+	if err := json.Unmarshal([]byte(res.Value.Get("text").Str()), result); err != nil {
+		return fmt.Errorf("failed to decode JSON from %s: %w", url, err)
+	}
+
+	return nil
+}
+
+// ResponseInfo describes a response the page received
+type ResponseInfo struct {
+	URL          string
+	Status       int
+	Headers      map[string]string
+	MIMEType     string
+	ResourceType string // "Document", "Script", "XHR", "Fetch", ...
+
+	page      *rod.Page
+	requestID proto.NetworkRequestID
+}
+
+// Body fetches the response body. It is available once the response finished loading,
+// so calling it from the OnResponse handler can fail for responses still streaming.
+func (r ResponseInfo) Body() ([]byte, error) {
+	res, err := proto.NetworkGetResponseBody{RequestID: r.requestID}.Call(r.page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get response body of %s: %w", r.URL, err)
+	}
+
+	if !res.Base64Encoded {
+		return []byte(res.Body), nil
+	}
+
+	body, err := base64.StdEncoding.DecodeString(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response body of %s: %w", r.URL, err)
+	}
+
+	return body, nil
+}
+
+// OnResponse calls handler for every response the page receives, in order, until stop is called
+func (p *Page) OnResponse(handler func(ResponseInfo)) (stop func()) {
+	ctx, cancel := context.WithCancel(p.ctx)
+	page := p.page.Context(p.ctx)
+
+	wait := p.page.Context(ctx).EachEvent(func(e *proto.NetworkResponseReceived) {
+		headers := make(map[string]string, len(e.Response.Headers))
+		for name, value := range e.Response.Headers {
+			headers[name] = value.Str()
+		}
+
+		handler(ResponseInfo{
+			URL:          e.Response.URL,
+			Status:       e.Response.Status,
+			Headers:      headers,
+			MIMEType:     e.Response.MIMEType,
+			ResourceType: string(e.Type),
+			page:         page,
+			requestID:    e.RequestID,
+		})
+	})
+	p.listenNetwork(wait)
+
+	return cancel
+}
+
+// PerformanceEntry is an entry of the page's performance timeline, times are in milliseconds since navigation start
+type PerformanceEntry struct {
+	Name      string  `json:"name"`
+	EntryType string  `json:"entryType"`
+	StartTime float64 `json:"startTime"`
+	Duration  float64 `json:"duration"`
+}
+
+// PerformanceEntries returns the performance timeline entries of entryType, e.g. "resource", "navigation" or "mark"
+func (p *Page) PerformanceEntries(entryType string) ([]PerformanceEntry, error) {
+	res, err := p.EvalJS(`(type) => performance.getEntriesByType(type).map(e => e.toJSON())`, entryType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s performance entries: %w", entryType, err)
+	}
+
+	var entries []PerformanceEntry
+	if err := res.JSON(&entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// NetworkStats summarizes the requests a page made
+type NetworkStats struct {
+	Requests         int           // requests sent, redirects count as separate requests
+	Failed           int           // requests that failed or were blocked
+	BytesTransferred int64         // encoded bytes received, including headers
+	SlowestURL       string        // URL of the slowest finished request
+	SlowestDuration  time.Duration // time from sending to finishing the slowest request
+}
+
+// networkStats accumulates NetworkStats from Network events
+type networkStats struct {
+	mu      sync.Mutex
+	stats   NetworkStats
+	pending map[proto.NetworkRequestID]*proto.NetworkRequestWillBeSent
+}
+
+// NetworkSummary returns the stats of the requests made since the page was created.
+// Tracking must be enabled with BrowserOptions.TrackNetwork, otherwise the stats are empty.
+func (p *Page) NetworkSummary() NetworkStats {
+	p.mu.RLock()
+	tracker := p.networkStats
+	p.mu.RUnlock()
+
+	if tracker == nil {
+		return NetworkStats{}
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	return tracker.stats
+}
+
+// trackNetwork starts accumulating the page's network stats for NetworkSummary
+func (p *Page) trackNetwork() {
+	tracker := &networkStats{pending: map[proto.NetworkRequestID]*proto.NetworkRequestWillBeSent{}}
+
+	wait := p.page.Context(p.ctx).EachEvent(func(e *proto.NetworkRequestWillBeSent) {
+		tracker.mu.Lock()
+		defer tracker.mu.Unlock()
+
+		tracker.stats.Requests++
+		tracker.pending[e.RequestID] = e
+	}, func(e *proto.NetworkLoadingFinished) {
+		tracker.mu.Lock()
+		defer tracker.mu.Unlock()
+
+		tracker.stats.BytesTransferred += int64(e.EncodedDataLength)
+		if sent, ok := tracker.pending[e.RequestID]; ok {
+			if d := (e.Timestamp - sent.Timestamp).Duration(); d > tracker.stats.SlowestDuration {
+				tracker.stats.SlowestDuration = d
+				tracker.stats.SlowestURL = sent.Request.URL
+			}
+			delete(tracker.pending, e.RequestID)
+		}
+	}, func(e *proto.NetworkLoadingFailed) {
+		tracker.mu.Lock()
+		defer tracker.mu.Unlock()
+
+		tracker.stats.Failed++
+		delete(tracker.pending, e.RequestID)
+	})
+	p.listenNetwork(wait)
+
+	p.mu.Lock()
+	p.networkStats = tracker
+	p.mu.Unlock()
+}
+
+// newInterceptedRequest converts a paused CDP request into an InterceptedRequest
+func newInterceptedRequest(e *proto.FetchRequestPaused) *InterceptedRequest {
+	headers := make(map[string]string, len(e.Request.Headers))
+	for name, value := range e.Request.Headers {
+		headers[name] = value.Str()
+	}
+
+	return &InterceptedRequest{
+		URL:          e.Request.URL,
+		Method:       e.Request.Method,
+		Headers:      headers,
+		PostData:     e.Request.PostData,
+		ResourceType: string(e.ResourceType),
+	}
+}
+
+// respondToPausedRequest fulfills the paused request with resp, or continues it when resp is nil
+func respondToPausedRequest(page *rod.Page, requestID proto.FetchRequestID, resp *InterceptResponse) error {
+	if resp == nil {
+		return proto.FetchContinueRequest{RequestID: requestID}.Call(page)
+	}
+
+	status := resp.Status
+	if status == 0 {
+		status = 200
+	}
+
+	headers := make([]*proto.FetchHeaderEntry, 0, len(resp.Headers))
+	for name, value := range resp.Headers {
+		headers = append(headers, &proto.FetchHeaderEntry{Name: name, Value: value})
+	}
+
+	return proto.FetchFulfillRequest{
+		RequestID:       requestID,
+		ResponseCode:    status,
+		ResponseHeaders: headers,
+		Body:            resp.Body,
+	}.Call(page)
+}
+
+// matchesContentType reports whether the Accept or Content-Type header lists one of the MIME types
+func matchesContentType(headers map[string]string, types []string) bool {
+	for name, value := range headers {
+		if !strings.EqualFold(name, "Accept") && !strings.EqualFold(name, "Content-Type") {
+			continue
+		}
+		for _, part := range strings.Split(value, ",") {
+			mime := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+			for _, t := range types {
+				if strings.EqualFold(mime, t) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
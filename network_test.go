@@ -0,0 +1,464 @@
+package rodwer
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// NetworkTestSuite covers request interception and network helpers
+type NetworkTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *NetworkTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *NetworkTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *NetworkTestSuite) TestSetContentAndHeaders() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	// Record the document response headers as seen by the browser
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var documentHeaders proto.NetworkHeaders
+	go page.page.Context(ctx).EachEvent(func(e *proto.NetworkResponseReceived) {
+		if e.Type == proto.NetworkResourceTypeDocument {
+			mu.Lock()
+			documentHeaders = e.Response.Headers
+			mu.Unlock()
+		}
+	})()
+
+	html := `<html><head><title>Served</title></head><body><h1 id="served">Served content</h1></body></html>`
+	err = page.SetContentAndHeaders(html, map[string]string{"X-Custom-Header": "rodwer"}, 201)
+	s.Require().NoError(err)
+
+	el, err := page.Element("#served")
+	s.Require().NoError(err)
+	text, err := el.Text()
+	s.Require().NoError(err)
+	s.Equal("Served content", text)
+
+	// The navigation timing entry reflects the fulfilled response
+	res, err := page.page.Eval(`() => performance.getEntriesByType('navigation')[0].responseStatus`)
+	s.Require().NoError(err)
+	s.Equal(201, res.Value.Int())
+
+	mu.Lock()
+	defer mu.Unlock()
+	s.Require().NotNil(documentHeaders, "document response should have been observed")
+	s.Equal("rodwer", documentHeaders["X-Custom-Header"].String())
+}
+
+func (s *NetworkTestSuite) TestSetInterceptContentTypes() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/api/data", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"source":"server"}`))
+	})
+	testServer.AddRoute("/plain", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("server"))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.Navigate(testServer.URL)
+	s.Require().NoError(err)
+
+	var mu sync.Mutex
+	var intercepted []string
+	stop, err := page.SetInterceptContentTypes([]string{"application/json"}, func(req *InterceptedRequest) *InterceptResponse {
+		mu.Lock()
+		intercepted = append(intercepted, req.URL)
+		mu.Unlock()
+		return &InterceptResponse{
+			Status:  200,
+			Headers: map[string]string{"Content-Type": "application/json"},
+			Body:    []byte(`{"source":"mock"}`),
+		}
+	})
+	s.Require().NoError(err)
+	defer stop()
+
+	res, err := page.page.Eval(`async () => {
+		const api = await fetch('/api/data', { headers: { 'Accept': 'application/json' } }).then(r => r.json());
+		const plain = await fetch('/plain').then(r => r.text());
+		return { api: api.source, plain: plain };
+	}`)
+	s.Require().NoError(err)
+	s.Equal("mock", res.Value.Get("api").Str(), "JSON API call should be intercepted")
+	s.Equal("server", res.Value.Get("plain").Str(), "Other requests should reach the server")
+
+	mu.Lock()
+	urls := append([]string(nil), intercepted...)
+	mu.Unlock()
+	s.Require().Len(urls, 1)
+	s.Contains(urls[0], "/api/data")
+
+	s.Run("stop restores normal traffic", func() {
+		stop()
+		res, err := page.page.Timeout(5 * time.Second).Eval(`() => fetch('/api/data', { headers: { 'Accept': 'application/json' } }).then(r => r.json())`)
+		s.Require().NoError(err)
+		s.Equal("server", res.Value.Get("source").Str())
+	})
+}
+
+func (s *NetworkTestSuite) TestPauseNetwork() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/api/ping", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("pong"))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.Navigate(testServer.URL)
+	s.Require().NoError(err)
+
+	result := func() string {
+		res, err := page.page.Eval(`() => window.pingResult`)
+		s.Require().NoError(err)
+		return res.Value.Str()
+	}
+
+	err = page.PauseNetwork()
+	s.Require().NoError(err)
+	s.Error(page.PauseNetwork(), "Pausing twice should fail")
+
+	_, err = page.page.Eval(`() => {
+		window.pingResult = 'pending';
+		fetch('/api/ping').then(r => r.text()).then(t => { window.pingResult = t; });
+	}`)
+	s.Require().NoError(err)
+
+	time.Sleep(300 * time.Millisecond)
+	s.Equal("pending", result(), "Fetch should not resolve while the network is paused")
+
+	err = page.ResumeNetwork()
+	s.Require().NoError(err)
+
+	s.Eventually(func() bool {
+		return result() == "pong"
+	}, 5*time.Second, 50*time.Millisecond, "Held fetch should complete after resume")
+
+	s.Error(page.ResumeNetwork(), "Resuming without a pause should fail")
+}
+
+func (s *NetworkTestSuite) TestSetExtraHTTPHeaders() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/headers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("token=" + r.Header.Get("X-Test-Token")))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	body := func() string {
+		s.Require().NoError(page.Navigate(testServer.URL + "/headers"))
+		res, err := page.Evaluate(`() => document.body.innerText`)
+		s.Require().NoError(err)
+		return res.(string)
+	}
+
+	s.Require().NoError(page.SetExtraHTTPHeaders(map[string]string{"X-Test-Token": "secret"}))
+	s.Equal("token=secret", body())
+	s.Equal("token=secret", body(), "Headers should persist across navigations")
+
+	s.Run("headers survive a listener that enabled Network first", func() {
+		stop := page.OnResponse(func(ResponseInfo) {})
+		s.Require().NoError(page.SetExtraHTTPHeaders(map[string]string{"X-Test-Token": "listener"}))
+		s.Equal("token=listener", body())
+
+		// Stopping disables the Network domain the listener enabled, the headers are re-applied before it counts as closed
+		stop()
+		s.Eventually(func() bool { return page.OpenHandles() == 0 }, 2*time.Second, 20*time.Millisecond)
+		s.Equal("token=listener", body())
+	})
+
+	s.Require().NoError(page.SetExtraHTTPHeaders(map[string]string{}))
+	s.Equal("token=", body(), "An empty map removes the headers")
+}
+
+func (s *NetworkTestSuite) TestFetchJSON() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/api/user", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			w.Write([]byte(`{"id": 2, "name": "` + r.Header.Get("X-Name") + `", "echo": ` + string(body) + `}`))
+			return
+		}
+		w.Write([]byte(`{"id": 1, "name": "alice", "roles": ["admin", "dev"]}`))
+	})
+	testServer.AddRoute("/api/broken", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL))
+
+	type user struct {
+		ID    int      `json:"id"`
+		Name  string   `json:"name"`
+		Roles []string `json:"roles"`
+		Echo  struct {
+			Active bool `json:"active"`
+		} `json:"echo"`
+	}
+
+	s.Run("GET", func() {
+		var u user
+		s.Require().NoError(page.FetchJSON("/api/user", FetchOptions{}, &u))
+		s.Equal(1, u.ID)
+		s.Equal("alice", u.Name)
+		s.Equal([]string{"admin", "dev"}, u.Roles)
+	})
+
+	s.Run("POST with headers and body", func() {
+		var u user
+		err := page.FetchJSON("/api/user", FetchOptions{
+			Method:  "POST",
+			Body:    `{"active": true}`,
+			Headers: map[string]string{"X-Name": "bob"},
+		}, &u)
+		s.Require().NoError(err)
+		s.Equal(2, u.ID)
+		s.Equal("bob", u.Name)
+		s.True(u.Echo.Active)
+	})
+
+	s.Run("error status", func() {
+		var u user
+		err := page.FetchJSON("/api/broken", FetchOptions{}, &u)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "500")
+	})
+}
+
+func (s *NetworkTestSuite) TestNetworkSummary() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head>
+			<link rel="stylesheet" href="/stats/style.css">
+			<script src="/stats/app.js"></script>
+		</head><body>stats</body></html>`))
+	})
+	testServer.AddRoute("/stats/style.css", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/css")
+		w.Write([]byte("body { color: black; }"))
+	})
+	testServer.AddRoute("/stats/app.js", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Header().Set("Content-Type", "text/javascript")
+		w.Write([]byte("window.loaded = true;"))
+	})
+
+	browser, err := NewBrowser(BrowserOptions{Headless: true, NoSandbox: true, TrackNetwork: true})
+	s.Require().NoError(err)
+	defer browser.Close()
+
+	page, err := browser.NewPage()
+	s.Require().NoError(err)
+
+	s.Require().NoError(page.Navigate(testServer.URL + "/stats"))
+
+	// loadingFinished may trail the load event slightly
+	s.Eventually(func() bool {
+		return page.NetworkSummary().SlowestURL == testServer.URL+"/stats/app.js"
+	}, 5*time.Second, 50*time.Millisecond, "The delayed script should be the slowest request")
+
+	stats := page.NetworkSummary()
+	s.GreaterOrEqual(stats.Requests, 3, "Document, stylesheet and script")
+	s.Less(stats.Requests, 10)
+	s.Zero(stats.Failed)
+	s.Positive(stats.BytesTransferred)
+	s.GreaterOrEqual(stats.SlowestDuration, 200*time.Millisecond)
+
+	s.Run("tracking is off by default", func() {
+		untracked, err := s.browser.NewPage()
+		s.Require().NoError(err)
+		defer untracked.Close()
+
+		s.Require().NoError(untracked.Navigate(testServer.URL + "/stats"))
+		s.Equal(NetworkStats{}, untracked.NetworkSummary())
+	})
+}
+
+func (s *NetworkTestSuite) TestPerformanceEntries() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/waterfall", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><script src="/waterfall/app.js"></script></head><body>waterfall</body></html>`))
+	})
+	testServer.AddRoute("/waterfall/app.js", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "text/javascript")
+		w.Write([]byte("window.loaded = true;"))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL + "/waterfall"))
+
+	s.Run("resource entries", func() {
+		entries, err := page.PerformanceEntries("resource")
+		s.Require().NoError(err)
+
+		var script *PerformanceEntry
+		for i := range entries {
+			if entries[i].Name == testServer.URL+"/waterfall/app.js" {
+				script = &entries[i]
+			}
+		}
+		s.Require().NotNil(script, "expected the script in %v", entries)
+		s.Equal("resource", script.EntryType)
+		s.Positive(script.StartTime)
+		s.GreaterOrEqual(script.Duration, 50.0)
+	})
+
+	s.Run("document entry", func() {
+		entries, err := page.PerformanceEntries("navigation")
+		s.Require().NoError(err)
+		s.Require().Len(entries, 1)
+		s.Equal(testServer.URL+"/waterfall", entries[0].Name)
+		s.Equal("navigation", entries[0].EntryType)
+	})
+
+	s.Run("unknown type", func() {
+		entries, err := page.PerformanceEntries("no-such-type")
+		s.Require().NoError(err)
+		s.Empty(entries)
+	})
+}
+
+func (s *NetworkTestSuite) TestOnResponse() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/missing.js", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	testServer.AddRoute("/app", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body><h1>App</h1><script src="/missing.js"></script></body></html>`))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	var mu sync.Mutex
+	var responses []ResponseInfo
+	stop := page.OnResponse(func(r ResponseInfo) {
+		mu.Lock()
+		defer mu.Unlock()
+		responses = append(responses, r)
+	})
+	defer stop()
+
+	s.Require().NoError(page.Navigate(testServer.URL + "/app"))
+
+	find := func(url string) *ResponseInfo {
+		mu.Lock()
+		defer mu.Unlock()
+		for i := range responses {
+			if responses[i].URL == url {
+				return &responses[i]
+			}
+		}
+		return nil
+	}
+
+	s.Eventually(func() bool {
+		return find(testServer.URL+"/missing.js") != nil
+	}, 3*time.Second, 50*time.Millisecond)
+
+	document := find(testServer.URL + "/app")
+	s.Require().NotNil(document, "The document response should be observed")
+	s.Equal(200, document.Status)
+	s.Equal("Document", document.ResourceType)
+	s.Equal("text/html", document.MIMEType)
+	s.Equal("text/html", document.Headers["Content-Type"])
+
+	body, err := document.Body()
+	s.Require().NoError(err)
+	s.Contains(string(body), "<h1>App</h1>")
+
+	s.Equal(404, find(testServer.URL+"/missing.js").Status)
+}
+
+func TestMatchesContentType(t *testing.T) {
+	types := []string{"application/json", "text/javascript"}
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    bool
+	}{
+		{"accept json", map[string]string{"Accept": "application/json"}, true},
+		{"accept list with params", map[string]string{"accept": "text/html, application/json;q=0.9"}, true},
+		{"content type with charset", map[string]string{"Content-Type": "application/json; charset=utf-8"}, true},
+		{"wildcard accept", map[string]string{"Accept": "*/*"}, false},
+		{"unrelated header", map[string]string{"X-Type": "application/json"}, false},
+		{"no headers", map[string]string{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesContentType(tt.headers, types))
+		})
+	}
+}
+
+// Run the network test suite
+func TestNetworkSuite(t *testing.T) {
+	suite.Run(t, new(NetworkTestSuite))
+}
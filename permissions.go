@@ -0,0 +1,70 @@
+package rodwer
+
+import (
+	"fmt"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// BrowserPermission is a permission a page can be granted without a prompt
+type BrowserPermission string
+
+// Common permissions accepted by SetPermissions, any other CDP permission name works too
+const (
+	PermissionNotifications  BrowserPermission = "notifications"
+	PermissionGeolocation    BrowserPermission = "geolocation"
+	PermissionCamera         BrowserPermission = "videoCapture"
+	PermissionMicrophone     BrowserPermission = "audioCapture"
+	PermissionClipboardRead  BrowserPermission = "clipboardReadWrite"
+	PermissionClipboardWrite BrowserPermission = "clipboardSanitizedWrite"
+	PermissionMidi           BrowserPermission = "midi"
+)
+
+// SetPermissions grants permissions to origin, e.g. "http://localhost:8080", or to every origin when empty.
+// Permissions that are not listed keep their previous state.
+func (p *Page) SetPermissions(origin string, permissions []BrowserPermission) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	types := make([]proto.BrowserPermissionType, len(permissions))
+	for i, permission := range permissions {
+		types[i] = proto.BrowserPermissionType(permission)
+	}
+
+	browser := p.page.Browser()
+	err := proto.BrowserGrantPermissions{
+		Permissions:      types,
+		Origin:           origin,
+		BrowserContextID: browser.BrowserContextID,
+	}.Call(browser)
+	if err != nil {
+		return fmt.Errorf("failed to grant permissions: %w", err)
+	}
+
+	return nil
+}
+
+// ResetPermissions restores the default state of every permission granted with SetPermissions.
+// Permissions are shared by all pages of the browser, so this resets them for other pages too.
+func (p *Page) ResetPermissions() error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	browser := p.page.Browser()
+	err := proto.BrowserResetPermissions{BrowserContextID: browser.BrowserContextID}.Call(browser)
+	if err != nil {
+		return fmt.Errorf("failed to reset permissions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package rodwer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// PermissionsTestSuite covers granting and resetting browser permissions
+type PermissionsTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *PermissionsTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *PermissionsTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *PermissionsTestSuite) TestSetPermissions() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL))
+
+	notificationPermission := func() string {
+		result, err := page.EvalJS("Notification.permission")
+		s.Require().NoError(err)
+		return result.String()
+	}
+
+	s.Equal("default", notificationPermission())
+
+	err = page.SetPermissions(testServer.URL, []BrowserPermission{PermissionNotifications, PermissionGeolocation})
+	s.Require().NoError(err)
+	s.Equal("granted", notificationPermission())
+
+	geolocation, err := page.EvalAsync(`navigator.permissions.query({ name: 'geolocation' }).then(p => p.state)`)
+	s.Require().NoError(err)
+	s.Equal("granted", geolocation.Str())
+
+	s.Require().NoError(page.ResetPermissions())
+	s.Equal("default", notificationPermission())
+
+	s.Run("unknown permission", func() {
+		s.Error(page.SetPermissions(testServer.URL, []BrowserPermission{"teleportation"}))
+	})
+}
+
+// Run the permissions test suite
+func TestPermissionsSuite(t *testing.T) {
+	suite.Run(t, new(PermissionsTestSuite))
+}
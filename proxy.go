@@ -0,0 +1,98 @@
+package rodwer
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-rod/rod/lib/launcher"
+)
+
+// ProxyConfig routes browser traffic through proxies. Chrome reads proxy settings at launch.
+type ProxyConfig struct {
+	Server string      // proxy for all traffic, e.g. "http://proxy:8080" or "socks5://proxy:1080"
+	Bypass []string    // hosts that skip Server, in --proxy-bypass-list syntax
+	Rules  []ProxyRule // per-URL proxies, checked in order before Server; unmatched URLs go direct when Server is empty
+}
+
+// ProxyRule sends requests whose URL matches URLPattern through ProxyServer
+type ProxyRule struct {
+	URLPattern  string // shell expression, e.g. "*://api.example.com/*"
+	ProxyServer string // e.g. "http://proxy:8080", or "direct" to skip proxies
+}
+
+// validateProxyConfig checks that every rule is complete
+func validateProxyConfig(config *ProxyConfig) error {
+	for i, rule := range config.Rules {
+		if rule.URLPattern == "" {
+			return fmt.Errorf("proxy rule %d has no URL pattern", i)
+		}
+		if rule.ProxyServer == "" {
+			return fmt.Errorf("proxy rule %d has no proxy server", i)
+		}
+	}
+	return nil
+}
+
+// applyProxyConfig sets the launcher flags for config.
+// Plain configs map to --proxy-server and --proxy-bypass-list, per-URL rules need a PAC script.
+func applyProxyConfig(l *launcher.Launcher, config *ProxyConfig) {
+	if len(config.Rules) == 0 {
+		if config.Server != "" {
+			l.Set("proxy-server", config.Server)
+		}
+		if len(config.Bypass) > 0 {
+			l.Set("proxy-bypass-list", strings.Join(config.Bypass, ";"))
+		}
+		return
+	}
+
+	pac := base64.StdEncoding.EncodeToString([]byte(proxyPACScript(config)))
+	l.Set("proxy-pac-url", "data:application/x-ns-proxy-autoconfig;base64,"+pac)
+}
+
+// proxyPACScript renders config as a proxy auto-config script
+func proxyPACScript(config *ProxyConfig) string {
+	var b strings.Builder
+	b.WriteString("function FindProxyForURL(url, host) {\n")
+
+	for _, host := range config.Bypass {
+		fmt.Fprintf(&b, "  if (shExpMatch(host, %s)) return \"DIRECT\";\n", strconv.Quote(host))
+	}
+
+	for _, rule := range config.Rules {
+		fmt.Fprintf(&b, "  if (shExpMatch(url, %s)) return %s;\n", strconv.Quote(rule.URLPattern), strconv.Quote(pacProxy(rule.ProxyServer)))
+	}
+
+	fallback := "DIRECT"
+	if config.Server != "" {
+		fallback = pacProxy(config.Server)
+	}
+	fmt.Fprintf(&b, "  return %s;\n}\n", strconv.Quote(fallback))
+
+	return b.String()
+}
+
+// pacProxy converts a proxy server URL into a PAC result such as "PROXY host:port"
+func pacProxy(server string) string {
+	if strings.EqualFold(server, "direct") {
+		return "DIRECT"
+	}
+
+	scheme, hostPort, found := strings.Cut(server, "://")
+	if !found {
+		return "PROXY " + server
+	}
+
+	switch strings.ToLower(scheme) {
+	case "https":
+		return "HTTPS " + hostPort
+	case "socks", "socks4":
+		return "SOCKS " + hostPort
+	case "socks5":
+		return "SOCKS5 " + hostPort
+	default:
+		return "PROXY " + hostPort
+	}
+}
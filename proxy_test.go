@@ -0,0 +1,50 @@
+package rodwer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProxyPACScript(t *testing.T) {
+	config := &ProxyConfig{
+		Server: "http://fallback:3128",
+		Bypass: []string{"*.internal"},
+		Rules: []ProxyRule{
+			{URLPattern: "*://api.example.com/*", ProxyServer: "socks5://socks:1080"},
+			{URLPattern: "*://cdn.example.com/*", ProxyServer: "direct"},
+		},
+	}
+
+	want := `function FindProxyForURL(url, host) {
+  if (shExpMatch(host, "*.internal")) return "DIRECT";
+  if (shExpMatch(url, "*://api.example.com/*")) return "SOCKS5 socks:1080";
+  if (shExpMatch(url, "*://cdn.example.com/*")) return "DIRECT";
+  return "PROXY fallback:3128";
+}
+`
+	assert.Equal(t, want, proxyPACScript(config))
+
+	t.Run("no server means direct fallback", func(t *testing.T) {
+		script := proxyPACScript(&ProxyConfig{Rules: []ProxyRule{{URLPattern: "*", ProxyServer: "proxy:8080"}}})
+		assert.Contains(t, script, `return "PROXY proxy:8080";`)
+		assert.Contains(t, script, `return "DIRECT";`)
+	})
+}
+
+func TestPacProxy(t *testing.T) {
+	tests := map[string]string{
+		"proxy:8080":          "PROXY proxy:8080",
+		"http://proxy:8080":   "PROXY proxy:8080",
+		"https://proxy:443":   "HTTPS proxy:443",
+		"socks://proxy:1080":  "SOCKS proxy:1080",
+		"socks5://proxy:1080": "SOCKS5 proxy:1080",
+		"DIRECT":              "DIRECT",
+	}
+
+	for server, want := range tests {
+		t.Run(server, func(t *testing.T) {
+			assert.Equal(t, want, pacProxy(server))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package rodwer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-rod/rod/lib/launcher"
+)
+
+// ReconnectOnCrash watches the browser process and, when it exits without Close being called,
+// relaunches it with the browser's options and reconnects, retrying for up to reconnectTimeout.
+// Pages of the crashed process are gone, new pages are created on the relaunched browser.
+// The download behavior set with SetDefaultDownloadBehavior or DisableDownloads is applied again.
+// When relaunching fails within the timeout, watching stops and IsConnected stays false.
+func (b *Browser) ReconnectOnCrash(reconnectTimeout time.Duration) error {
+	if reconnectTimeout <= 0 {
+		return fmt.Errorf("reconnect timeout must be positive, got %s", reconnectTimeout)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return fmt.Errorf("browser is closed")
+	}
+
+	if b.watched {
+		return fmt.Errorf("browser is already watched for crashes")
+	}
+
+	b.watched = true
+	go b.watchProcess(b.launcher, reconnectTimeout)
+
+	return nil
+}
+
+// watchProcess waits for the process started by l to exit and relaunches the browser until Close is called
+func (b *Browser) watchProcess(l *launcher.Launcher, reconnectTimeout time.Duration) {
+	for {
+		// Cleanup blocks until the process exits, then removes its user data dir
+		l.Cleanup()
+
+		b.mu.RLock()
+		closed := b.closed
+		b.mu.RUnlock()
+
+		if closed {
+			return
+		}
+
+		next := b.relaunch(reconnectTimeout)
+		if next == nil {
+			return
+		}
+
+		l = next
+	}
+}
+
+// relaunch starts a new browser process and swaps it in, returning its launcher.
+// It returns nil when the browser was closed meanwhile or no process started within reconnectTimeout.
+func (b *Browser) relaunch(reconnectTimeout time.Duration) *launcher.Launcher {
+	deadline := time.Now().Add(reconnectTimeout)
+
+	for {
+		if browser, l, err := launchBrowser(b.options); err == nil {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			if b.closed {
+				_ = browser.Close()
+				l.Cleanup()
+				return nil
+			}
+
+			b.browser = browser
+			b.launcher = l
+
+			// Browser level settings live in the crashed process, apply them again
+			if b.downloadBehavior != nil {
+				_ = b.downloadBehavior.Call(browser)
+			}
+
+			return l
+		}
+
+		if time.Now().Add(RetryDelay).After(deadline) {
+			return nil
+		}
+
+		select {
+		case <-b.ctx.Done():
+			return nil
+		case <-time.After(RetryDelay):
+		}
+	}
+}
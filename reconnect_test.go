@@ -0,0 +1,89 @@
+package rodwer
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ReconnectTestSuite covers relaunching the browser after its process crashed
+type ReconnectTestSuite struct {
+	suite.Suite
+}
+
+func (s *ReconnectTestSuite) TestReconnectOnCrash() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body><a id="file" href="/file.txt">file</a></body></html>`))
+	})
+	testServer.AddRoute("/file.txt", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", "attachment; filename=file.txt")
+		w.Write([]byte("downloaded after reconnect"))
+	})
+
+	browser, err := NewBrowser(BrowserOptions{Headless: true, UserAgent: "rodwer-reconnect"})
+	s.Require().NoError(err)
+	defer browser.Close()
+
+	dir := s.T().TempDir()
+	s.Require().NoError(browser.SetDefaultDownloadBehavior(dir))
+
+	s.Require().NoError(browser.ReconnectOnCrash(10 * time.Second))
+	s.Error(browser.ReconnectOnCrash(10*time.Second), "Watching twice should fail")
+
+	// Kill the browser process behind the library's back
+	browser.mu.RLock()
+	crashed := browser.launcher
+	browser.mu.RUnlock()
+	crashed.Kill()
+
+	s.Eventually(func() bool {
+		browser.mu.RLock()
+		relaunched := browser.launcher != crashed
+		browser.mu.RUnlock()
+		return relaunched && browser.IsConnected()
+	}, 10*time.Second, 100*time.Millisecond, "Browser should reconnect after its process was killed")
+
+	s.Run("options are applied to the relaunched browser", func() {
+		page, err := browser.NewPage()
+		s.Require().NoError(err)
+		defer page.Close()
+
+		res, err := page.Evaluate(`() => navigator.userAgent`)
+		s.Require().NoError(err)
+		s.Equal("rodwer-reconnect", res)
+	})
+
+	s.Run("download behavior is applied to the relaunched browser", func() {
+		page, err := browser.NewPage()
+		s.Require().NoError(err)
+		defer page.Close()
+
+		s.Require().NoError(page.Navigate(testServer.URL + "/download"))
+		link, err := page.Element("#file")
+		s.Require().NoError(err)
+		s.Require().NoError(link.Click())
+
+		s.Eventually(func() bool {
+			data, err := os.ReadFile(filepath.Join(dir, "file.txt"))
+			return err == nil && string(data) == "downloaded after reconnect"
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+
+	s.Run("invalid timeout", func() {
+		s.Error(browser.ReconnectOnCrash(0))
+	})
+}
+
+// Run the reconnect test suite
+func TestReconnectSuite(t *testing.T) {
+	suite.Run(t, new(ReconnectTestSuite))
+}
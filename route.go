@@ -0,0 +1,320 @@
+package rodwer
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// RouteHandler handles a request matched by Page.Route
+type RouteHandler func(r *Route)
+
+// RouteResponse is the mocked response passed to Route.Fulfill
+type RouteResponse = InterceptResponse
+
+// RequestOverride changes a request continued with Route.Continue, empty fields keep the original value
+type RequestOverride struct {
+	URL      string
+	Method   string
+	PostData string
+	Headers  map[string]string // replaces all request headers when set
+}
+
+// Route is a request paused by Page.Route. Handlers resolve it with Fulfill, Abort or Continue,
+// unresolved requests are continued once the handler returns.
+type Route struct {
+	page    *rod.Page
+	id      proto.FetchRequestID
+	request *InterceptedRequest
+
+	mu      sync.Mutex
+	handled bool
+}
+
+// Request returns the paused request
+func (r *Route) Request() *InterceptedRequest {
+	return r.request
+}
+
+// Fulfill answers the request with resp without contacting the server
+func (r *Route) Fulfill(resp RouteResponse) error {
+	return r.resolve("fulfill", func() error {
+		return respondToPausedRequest(r.page, r.id, &resp)
+	})
+}
+
+// Abort fails the request with errorCode, a Chrome network error reason such as "Failed",
+// "Aborted" or "BlockedByClient". An empty errorCode means "Failed".
+func (r *Route) Abort(errorCode string) error {
+	if errorCode == "" {
+		errorCode = string(proto.NetworkErrorReasonFailed)
+	}
+
+	return r.resolve("abort", func() error {
+		return proto.FetchFailRequest{RequestID: r.id, ErrorReason: proto.NetworkErrorReason(errorCode)}.Call(r.page)
+	})
+}
+
+// Continue sends the request to the server, applying overrides in order
+func (r *Route) Continue(overrides ...RequestOverride) error {
+	req := proto.FetchContinueRequest{RequestID: r.id}
+	for _, o := range overrides {
+		if o.URL != "" {
+			req.URL = o.URL
+		}
+		if o.Method != "" {
+			req.Method = o.Method
+		}
+		if o.PostData != "" {
+			req.PostData = []byte(o.PostData)
+		}
+		if o.Headers != nil {
+			req.Headers = make([]*proto.FetchHeaderEntry, 0, len(o.Headers))
+			for name, value := range o.Headers {
+				req.Headers = append(req.Headers, &proto.FetchHeaderEntry{Name: name, Value: value})
+			}
+		}
+	}
+
+	return r.resolve("continue", func() error {
+		return req.Call(r.page)
+	})
+}
+
+// resolve runs action unless the route was already handled
+func (r *Route) resolve(name string, action func() error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.handled {
+		return fmt.Errorf("route for %s is already handled", r.request.URL)
+	}
+	r.handled = true
+
+	if err := action(); err != nil {
+		return fmt.Errorf("failed to %s request %s: %w", name, r.request.URL, err)
+	}
+
+	return nil
+}
+
+// pageRoutes holds the routes registered on a page
+type pageRoutes struct {
+	user   *fetchUser
+	routes []*pageRoute
+}
+
+// pageRoute is a single Page.Route registration
+type pageRoute struct {
+	pattern string
+	match   *regexp.Regexp
+	handler RouteHandler
+}
+
+// Route passes requests whose URL matches pattern to handler. pattern is a glob where "*" matches any
+// characters and "?" a single one. When several routes match, the most recently added one wins.
+// While PauseNetwork holds requests, routes see them once the network is resumed.
+func (p *Page) Route(pattern string, handler RouteHandler) error {
+	if pattern == "" {
+		return fmt.Errorf("route pattern cannot be empty")
+	}
+
+	if handler == nil {
+		return fmt.Errorf("route handler cannot be nil")
+	}
+
+	route := &pageRoute{pattern: pattern, match: globToRegexp(pattern), handler: handler}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return fmt.Errorf("page is closed")
+	}
+
+	first := p.routes == nil
+	if first {
+		p.routes = &pageRoutes{user: &fetchUser{take: p.handleRoute}}
+	}
+	p.routes.routes = append(p.routes.routes, route)
+	p.routes.user.patterns = routePatterns(p.routes.routes)
+	user := p.routes.user
+	p.mu.Unlock()
+
+	var err error
+	if first {
+		err = p.acquireFetch(user)
+	} else {
+		err = p.syncFetch()
+	}
+
+	if err != nil {
+		_, _ = p.removeRoutes(func(r *pageRoute) bool { return r == route })
+		return fmt.Errorf("failed to enable route %s: %w", pattern, err)
+	}
+
+	return nil
+}
+
+// Unroute removes every route registered with pattern
+func (p *Page) Unroute(pattern string) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	removed, err := p.removeRoutes(func(r *pageRoute) bool { return r.pattern == pattern })
+	if !removed {
+		return fmt.Errorf("no route registered for %s", pattern)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update routes: %w", err)
+	}
+
+	return nil
+}
+
+// removeRoutes drops the routes matching drop, reporting whether there were any,
+// and releases the Fetch domain once the last route is gone
+func (p *Page) removeRoutes(drop func(r *pageRoute) bool) (bool, error) {
+	p.mu.Lock()
+	routes := p.routes
+	if routes == nil {
+		p.mu.Unlock()
+		return false, nil
+	}
+
+	kept := make([]*pageRoute, 0, len(routes.routes))
+	for _, route := range routes.routes {
+		if !drop(route) {
+			kept = append(kept, route)
+		}
+	}
+
+	if len(kept) == len(routes.routes) {
+		p.mu.Unlock()
+		return false, nil
+	}
+
+	routes.routes = kept
+	routes.user.patterns = routePatterns(kept)
+	if len(kept) == 0 {
+		p.routes = nil
+	}
+	p.mu.Unlock()
+
+	if len(kept) == 0 {
+		return true, p.releaseFetch(routes.user)
+	}
+
+	return true, p.syncFetch()
+}
+
+// SetHeadersForHost adds headers to every request sent to host, e.g. "api.example.com" or "localhost:8080".
+// A host without a port matches any port. Requests to other hosts continue unchanged, so the headers
+// never reach third parties. It registers Page.Route handlers, routes added later take precedence.
+func (p *Page) SetHeadersForHost(host string, headers map[string]string) error {
+	if host == "" {
+		return fmt.Errorf("host cannot be empty")
+	}
+
+	if len(headers) == 0 {
+		return fmt.Errorf("no headers given for %s", host)
+	}
+
+	handler := func(r *Route) {
+		u, err := url.Parse(r.Request().URL)
+		if err != nil || !hostMatches(u, host) {
+			// The glob also matches the host in paths or query strings, leave those requests alone
+			return
+		}
+
+		merged := make(map[string]string, len(r.Request().Headers)+len(headers))
+		for name, value := range r.Request().Headers {
+			merged[name] = value
+		}
+		for name, value := range headers {
+			for existing := range merged {
+				if strings.EqualFold(existing, name) {
+					delete(merged, existing)
+				}
+			}
+			merged[name] = value
+		}
+
+		_ = r.Continue(RequestOverride{Headers: merged})
+	}
+
+	patterns := []string{"*://" + host + "/*"}
+	if !strings.Contains(host, ":") {
+		patterns = append(patterns, "*://"+host+":*/*")
+	}
+
+	for _, pattern := range patterns {
+		if err := p.Route(pattern, handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hostMatches reports whether u is on host, ignoring the port when host has none
+func hostMatches(u *url.URL, host string) bool {
+	if strings.EqualFold(u.Host, host) {
+		return true
+	}
+
+	return !strings.Contains(host, ":") && strings.EqualFold(u.Hostname(), host)
+}
+
+// handleRoute passes a paused request to the newest matching route and continues it if the handler didn't.
+// Requests no route matches are left to the page's other interceptions.
+func (p *Page) handleRoute(page *rod.Page, e *proto.FetchRequestPaused) bool {
+	var handler RouteHandler
+	p.mu.RLock()
+	if p.routes != nil {
+		for i := len(p.routes.routes) - 1; i >= 0; i-- {
+			if p.routes.routes[i].match.MatchString(e.Request.URL) {
+				handler = p.routes.routes[i].handler
+				break
+			}
+		}
+	}
+	p.mu.RUnlock()
+
+	if handler == nil {
+		return false
+	}
+
+	route := &Route{page: page, id: e.RequestID, request: newInterceptedRequest(e)}
+	handler(route)
+
+	route.mu.Lock()
+	handled := route.handled
+	route.mu.Unlock()
+
+	if !handled {
+		_ = proto.FetchContinueRequest{RequestID: e.RequestID}.Call(page)
+	}
+
+	return true
+}
+
+// routePatterns returns the Fetch patterns pausing requests matching any of the routes
+func routePatterns(routes []*pageRoute) []*proto.FetchRequestPattern {
+	patterns := make([]*proto.FetchRequestPattern, len(routes))
+	for i, route := range routes {
+		patterns[i] = &proto.FetchRequestPattern{URLPattern: route.pattern, RequestStage: proto.FetchRequestStageRequest}
+	}
+
+	return patterns
+}
@@ -0,0 +1,258 @@
+package rodwer
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// RouteTestSuite covers request routing with Page.Route
+type RouteTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *RouteTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *RouteTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *RouteTestSuite) TestRoute() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/api/user", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "` + r.Header.Get("X-User") + `from server"}`))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL))
+
+	fetchText := func(path string) string {
+		res, err := page.Evaluate(`(path) => fetch(path).then(r => r.text()).catch(e => 'error: ' + e.message)`, path)
+		s.Require().NoError(err)
+		return res.(string)
+	}
+
+	s.Run("fulfill with mocked JSON", func() {
+		var seen *InterceptedRequest
+		err := page.Route("*/api/user", func(r *Route) {
+			seen = r.Request()
+			s.NoError(r.Fulfill(RouteResponse{
+				Status:  200,
+				Headers: map[string]string{"Content-Type": "application/json"},
+				Body:    []byte(`{"name": "mocked"}`),
+			}))
+		})
+		s.Require().NoError(err)
+
+		res, err := page.Evaluate(`() => fetch('/api/user').then(r => r.json()).then(u => u.name)`)
+		s.Require().NoError(err)
+		s.Equal("mocked", res, "Page JavaScript should receive the mocked data")
+		s.Require().NotNil(seen)
+		s.Equal(testServer.URL+"/api/user", seen.URL)
+
+		s.Require().NoError(page.Unroute("*/api/user"))
+		s.Equal(`{"name": "from server"}`, fetchText("/api/user"))
+	})
+
+	s.Run("abort", func() {
+		s.Require().NoError(page.Route("*/api/*", func(r *Route) {
+			s.NoError(r.Abort("BlockedByClient"))
+		}))
+		defer page.Unroute("*/api/*")
+
+		s.Contains(fetchText("/api/user"), "error")
+	})
+
+	s.Run("continue with overrides", func() {
+		s.Require().NoError(page.Route("*/api/user", func(r *Route) {
+			s.NoError(r.Continue(RequestOverride{Headers: map[string]string{"X-User": "override "}}))
+			s.Error(r.Continue(), "A route can only be resolved once")
+		}))
+		defer page.Unroute("*/api/user")
+
+		s.Equal(`{"name": "override from server"}`, fetchText("/api/user"))
+	})
+
+	s.Run("newest route wins and unhandled requests continue", func() {
+		s.Require().NoError(page.Route("*/api/*", func(r *Route) {
+			s.NoError(r.Fulfill(RouteResponse{Body: []byte("older")}))
+		}))
+		s.Require().NoError(page.Route("*/api/user", func(r *Route) {}))
+
+		s.Equal(`{"name": "from server"}`, fetchText("/api/user"))
+
+		s.Require().NoError(page.Unroute("*/api/user"))
+		s.Equal("older", fetchText("/api/user"))
+		s.Require().NoError(page.Unroute("*/api/*"))
+	})
+
+	s.Run("unroute unknown pattern", func() {
+		s.Error(page.Unroute("*/nothing"))
+	})
+
+	s.Run("slow handlers don't block other requests", func() {
+		release := make(chan struct{})
+		s.Require().NoError(page.Route("*/slow", func(r *Route) {
+			<-release
+			s.NoError(r.Fulfill(RouteResponse{Body: []byte("slow")}))
+		}))
+		defer page.Unroute("*/slow")
+
+		_, err := page.Evaluate(`() => { window.slow = fetch('/slow').then(r => r.text()) }`)
+		s.Require().NoError(err)
+		s.Equal(`{"name": "from server"}`, fetchText("/api/user"))
+
+		close(release)
+		s.Eventually(func() bool {
+			res, err := page.Evaluate(`() => window.slow`)
+			return err == nil && res == "slow"
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+}
+
+func (s *RouteTestSuite) TestRouteWithPauseNetwork() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/api/ping", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("pong"))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL))
+
+	result := func() string {
+		res, err := page.Evaluate(`() => window.result`)
+		s.Require().NoError(err)
+		text, _ := res.(string)
+		return text
+	}
+
+	startFetch := func(path string) {
+		_, err := page.Evaluate(`(path) => {
+			window.result = 'pending';
+			fetch(path).then(r => r.text()).then(t => { window.result = t; }).catch(e => { window.result = 'error: ' + e.message; });
+		}`, path)
+		s.Require().NoError(err)
+	}
+
+	s.Run("held requests reach the route after resume", func() {
+		s.Require().NoError(page.Route("*/api/ping", func(r *Route) {
+			s.NoError(r.Fulfill(RouteResponse{Body: []byte("routed")}))
+		}))
+		s.Require().NoError(page.PauseNetwork())
+
+		startFetch("/api/ping")
+		time.Sleep(300 * time.Millisecond)
+		s.Equal("pending", result(), "The pause should hold requests the route matches")
+
+		s.Require().NoError(page.ResumeNetwork())
+		s.Eventually(func() bool { return result() == "routed" }, 5*time.Second, 50*time.Millisecond)
+
+		startFetch("/api/ping")
+		s.Eventually(func() bool { return result() == "routed" }, 5*time.Second, 50*time.Millisecond, "Resuming should keep the route")
+	})
+
+	s.Run("unroute keeps the pause", func() {
+		s.Require().NoError(page.PauseNetwork())
+		s.Require().NoError(page.Unroute("*/api/ping"))
+
+		startFetch("/api/ping")
+		time.Sleep(300 * time.Millisecond)
+		s.Equal("pending", result(), "Unroute should not disable the pause")
+
+		s.Require().NoError(page.ResumeNetwork())
+		s.Eventually(func() bool { return result() == "pong" }, 5*time.Second, 50*time.Millisecond)
+	})
+}
+
+func (s *RouteTestSuite) TestSetHeadersForHost() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("token=" + r.Header.Get("X-Api-Token") + ";agent=" + r.Header.Get("User-Agent")))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	// The test server listens on 127.0.0.1, reaching it as localhost makes a second host
+	err = page.SetHeadersForHost("localhost", map[string]string{"X-Api-Token": "secret"})
+	s.Require().NoError(err)
+
+	port := testServer.URL[strings.LastIndex(testServer.URL, ":")+1:]
+	body := func(url string) string {
+		s.Require().NoError(page.Navigate(url))
+		res, err := page.Evaluate(`() => document.body.innerText`)
+		s.Require().NoError(err)
+		return res.(string)
+	}
+
+	s.Run("header is added for the host", func() {
+		text := body("http://localhost:" + port + "/token")
+		s.Contains(text, "token=secret;")
+		s.NotContains(text, "agent=;", "Original headers are kept")
+	})
+
+	s.Run("other hosts don't get the header", func() {
+		s.Contains(body("http://127.0.0.1:"+port+"/token?next=http://localhost/"), "token=;")
+	})
+
+	s.Run("invalid arguments", func() {
+		s.Error(page.SetHeadersForHost("", map[string]string{"X-Api-Token": "secret"}))
+		s.Error(page.SetHeadersForHost("localhost", nil))
+	})
+}
+
+func TestHostMatches(t *testing.T) {
+	tests := []struct {
+		url  string
+		host string
+		want bool
+	}{
+		{"https://api.example.com/v1", "api.example.com", true},
+		{"http://API.example.com:8080/v1", "api.example.com", true},
+		{"http://api.example.com:8080/v1", "api.example.com:8080", true},
+		{"http://api.example.com:9090/v1", "api.example.com:8080", false},
+		{"https://cdn.example.com/?next=https://api.example.com/", "api.example.com", false},
+		{"https://api.example.com.evil.test/", "api.example.com", false},
+	}
+
+	for _, tt := range tests {
+		u, err := url.Parse(tt.url)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, hostMatches(u, tt.host), "%s on %s", tt.url, tt.host)
+	}
+}
+
+// Run the route test suite
+func TestRouteSuite(t *testing.T) {
+	suite.Run(t, new(RouteTestSuite))
+}
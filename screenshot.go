@@ -0,0 +1,202 @@
+package rodwer
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// screenshotFormat maps ScreenshotOptions.Format to a capture format, an empty format means PNG
+func screenshotFormat(format string) (proto.PageCaptureScreenshotFormat, error) {
+	switch strings.ToLower(format) {
+	case "", "png":
+		return proto.PageCaptureScreenshotFormatPng, nil
+	case "jpeg", "jpg":
+		return proto.PageCaptureScreenshotFormatJpeg, nil
+	default:
+		return "", fmt.Errorf("unsupported screenshot format %q: use \"png\" or \"jpeg\"", format)
+	}
+}
+
+// ScreenshotTiles captures the full page as viewport sized screenshots from top to bottom, scrolling between
+// captures and restoring the scroll position afterwards. The last tile is aligned with the bottom of the page,
+// so it overlaps the previous one when the page height isn't a multiple of the viewport height.
+// FullPage and Selector are ignored.
+func (p *Page) ScreenshotTiles(opts ScreenshotOptions) ([][]byte, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	defer p.browser.trackOp()()
+
+	res, err := p.page.Eval(`() => ({
+		x: window.scrollX,
+		y: window.scrollY,
+		viewport: window.innerHeight,
+		height: document.documentElement.scrollHeight,
+	})`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to measure page: %w", err)
+	}
+
+	viewport := res.Value.Get("viewport").Num()
+	if viewport <= 0 {
+		return nil, fmt.Errorf("viewport has no height")
+	}
+
+	defer func() {
+		_, _ = p.page.Eval(`(x, y) => window.scrollTo(x, y)`, res.Value.Get("x").Num(), res.Value.Get("y").Num())
+	}()
+
+	count := int(math.Ceil(res.Value.Get("height").Num() / viewport))
+	opts.FullPage = false
+
+	tiles := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		// Wait two frames so the scrolled content is painted before capturing
+		_, err := p.page.Eval(`(y) => new Promise(resolve => {
+			window.scrollTo(0, y);
+			requestAnimationFrame(() => requestAnimationFrame(resolve));
+		})`, float64(i)*viewport)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scroll to tile %d: %w", i+1, err)
+		}
+
+		data, err := p.screenshotPage(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to capture tile %d: %w", i+1, err)
+		}
+		tiles = append(tiles, data)
+	}
+
+	return tiles, nil
+}
+
+// GIFScreenshotOptions configures ScreenshotGIF
+type GIFScreenshotOptions struct {
+	Frames     int           // number of screenshots, at least 1
+	FrameDelay time.Duration // wait before each screenshot, also the display time of each frame
+	FullPage   bool          // capture the full page instead of the viewport
+}
+
+// ScreenshotGIF takes opts.Frames screenshots, waiting opts.FrameDelay before each, and encodes them
+// as an animated GIF, e.g. to record a hover transition
+func (p *Page) ScreenshotGIF(opts GIFScreenshotOptions) ([]byte, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	if opts.Frames < 1 {
+		return nil, fmt.Errorf("GIF needs at least one frame, got %d", opts.Frames)
+	}
+
+	if opts.FrameDelay < 0 {
+		return nil, fmt.Errorf("frame delay must not be negative, got %s", opts.FrameDelay)
+	}
+
+	defer p.browser.trackOp()()
+
+	frames := make([][]byte, 0, opts.Frames)
+	for i := 0; i < opts.Frames; i++ {
+		select {
+		case <-p.ctx.Done():
+			return nil, fmt.Errorf("page closed while capturing frame %d: %w", i+1, p.ctx.Err())
+		case <-time.After(opts.FrameDelay):
+		}
+
+		data, err := p.screenshotPage(ScreenshotOptions{FullPage: opts.FullPage})
+		if err != nil {
+			return nil, fmt.Errorf("failed to capture frame %d: %w", i+1, err)
+		}
+		frames = append(frames, data)
+	}
+
+	return encodeGIF(frames, opts.FrameDelay)
+}
+
+// encodeGIF combines PNG frames into a looping animated GIF showing each frame for delay
+func encodeGIF(frames [][]byte, delay time.Duration) ([]byte, error) {
+	// GIF delays are in hundredths of a second
+	centiseconds := int(delay / (10 * time.Millisecond))
+
+	anim := &gif.GIF{}
+	var bounds image.Rectangle
+	for i, frame := range frames {
+		img, err := png.Decode(bytes.NewReader(frame))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode frame %d: %w", i+1, err)
+		}
+
+		paletted := image.NewPaletted(img.Bounds(), palette.Plan9)
+		draw.FloydSteinberg.Draw(paletted, img.Bounds(), img, img.Bounds().Min)
+
+		anim.Image = append(anim.Image, paletted)
+		anim.Delay = append(anim.Delay, centiseconds)
+		bounds = bounds.Union(img.Bounds())
+	}
+
+	// Frames differ in size when the viewport changes during the recording, the canvas must fit all of them
+	anim.Config = image.Config{Width: bounds.Max.X, Height: bounds.Max.Y}
+
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, anim); err != nil {
+		return nil, fmt.Errorf("failed to encode GIF: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// capture runs the screenshot request, retrying blank results when options.RetryBlank is set
+func (p *Page) capture(req *proto.PageCaptureScreenshot, options ScreenshotOptions) ([]byte, error) {
+	capture := func() ([]byte, error) {
+		result, err := req.Call(p.page)
+		if err != nil {
+			return nil, err
+		}
+		return result.Data, nil
+	}
+
+	if !options.RetryBlank {
+		return capture()
+	}
+
+	settle := func() {
+		time.Sleep(RetryDelay)
+		_ = p.page.Timeout(StabilityWaitTimeout).WaitDOMStable(StabilityPollInterval, 0)
+	}
+
+	return retryBlankCapture(capture, settle)
+}
+
+// retryBlankCapture repeats capture, calling settle in between, while the result is smaller than
+// MinScreenshotSize. After MaxRetryAttempts the last capture is returned as is.
+func retryBlankCapture(capture func() ([]byte, error), settle func()) ([]byte, error) {
+	data, err := capture()
+	for attempt := 1; err == nil && len(data) < MinScreenshotSize && attempt < MaxRetryAttempts; attempt++ {
+		settle()
+		data, err = capture()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
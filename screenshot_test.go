@@ -0,0 +1,127 @@
+package rodwer
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryBlankCapture(t *testing.T) {
+	valid := bytes.Repeat([]byte{0x89}, MinScreenshotSize+1)
+	blank := []byte{0x89, 0x50}
+
+	t.Run("early blank capture retries to a valid image", func(t *testing.T) {
+		captures, settles := 0, 0
+		data, err := retryBlankCapture(func() ([]byte, error) {
+			captures++
+			if captures == 1 {
+				return blank, nil // taken before first paint
+			}
+			return valid, nil
+		}, func() { settles++ })
+
+		require.NoError(t, err)
+		assert.Equal(t, valid, data)
+		assert.Equal(t, 2, captures)
+		assert.Equal(t, 1, settles)
+	})
+
+	t.Run("valid capture is not retried", func(t *testing.T) {
+		captures := 0
+		data, err := retryBlankCapture(func() ([]byte, error) {
+			captures++
+			return valid, nil
+		}, func() {})
+
+		require.NoError(t, err)
+		assert.Equal(t, valid, data)
+		assert.Equal(t, 1, captures)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		captures := 0
+		data, err := retryBlankCapture(func() ([]byte, error) {
+			captures++
+			return blank, nil
+		}, func() {})
+
+		require.NoError(t, err)
+		assert.Equal(t, blank, data)
+		assert.Equal(t, MaxRetryAttempts, captures)
+	})
+
+	t.Run("capture error is returned", func(t *testing.T) {
+		_, err := retryBlankCapture(func() ([]byte, error) {
+			return nil, errors.New("capture failed")
+		}, func() {})
+
+		assert.EqualError(t, err, "capture failed")
+	})
+}
+
+func TestScreenshotFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		want   proto.PageCaptureScreenshotFormat
+	}{
+		{"", proto.PageCaptureScreenshotFormatPng},
+		{"png", proto.PageCaptureScreenshotFormatPng},
+		{"jpeg", proto.PageCaptureScreenshotFormatJpeg},
+		{"jpg", proto.PageCaptureScreenshotFormatJpeg},
+		{"JPG", proto.PageCaptureScreenshotFormatJpeg},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := screenshotFormat(tt.format)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := screenshotFormat("gif")
+	assert.ErrorContains(t, err, `unsupported screenshot format "gif"`)
+}
+
+func TestEncodeGIF(t *testing.T) {
+	frame := func(c color.Color, size ...int) []byte {
+		width, height := 8, 8
+		if len(size) == 2 {
+			width, height = size[0], size[1]
+		}
+		img := image.NewRGBA(image.Rect(0, 0, width, height))
+		draw.Draw(img, img.Bounds(), &image.Uniform{C: c}, image.Point{}, draw.Src)
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, img))
+		return buf.Bytes()
+	}
+
+	data, err := encodeGIF([][]byte{frame(color.White), frame(color.Black)}, 250*time.Millisecond)
+	require.NoError(t, err)
+
+	anim, err := gif.DecodeAll(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Len(t, anim.Image, 2)
+	assert.Equal(t, []int{25, 25}, anim.Delay, "Delays are in hundredths of a second")
+
+	data, err = encodeGIF([][]byte{frame(color.White, 8, 4), frame(color.Black, 6, 10)}, 100*time.Millisecond)
+	require.NoError(t, err, "Frames of different sizes are encoded")
+
+	anim, err = gif.DecodeAll(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 8, anim.Config.Width, "The canvas fits the widest frame")
+	assert.Equal(t, 10, anim.Config.Height, "The canvas fits the tallest frame")
+
+	_, err = encodeGIF([][]byte{[]byte("not a png")}, 0)
+	assert.ErrorContains(t, err, "failed to decode frame 1")
+}
@@ -0,0 +1,90 @@
+package rodwer
+
+import "fmt"
+
+// Web storage areas accessed by the storage helpers
+const (
+	localStorageArea   = "localStorage"
+	sessionStorageArea = "sessionStorage"
+)
+
+// GetLocalStorage returns the localStorage value of key, empty when the key is not set
+func (p *Page) GetLocalStorage(key string) (string, error) {
+	return p.getStorageItem(localStorageArea, key)
+}
+
+// SetLocalStorage stores value under key in localStorage
+func (p *Page) SetLocalStorage(key, value string) error {
+	return p.setStorageItem(localStorageArea, key, value)
+}
+
+// RemoveLocalStorage removes key from localStorage
+func (p *Page) RemoveLocalStorage(key string) error {
+	return p.removeStorageItem(localStorageArea, key)
+}
+
+// ClearLocalStorage removes every key from localStorage
+func (p *Page) ClearLocalStorage() error {
+	return p.clearStorage(localStorageArea)
+}
+
+// GetSessionStorage returns the sessionStorage value of key, empty when the key is not set
+func (p *Page) GetSessionStorage(key string) (string, error) {
+	return p.getStorageItem(sessionStorageArea, key)
+}
+
+// SetSessionStorage stores value under key in sessionStorage
+func (p *Page) SetSessionStorage(key, value string) error {
+	return p.setStorageItem(sessionStorageArea, key, value)
+}
+
+// RemoveSessionStorage removes key from sessionStorage
+func (p *Page) RemoveSessionStorage(key string) error {
+	return p.removeStorageItem(sessionStorageArea, key)
+}
+
+// ClearSessionStorage removes every key from sessionStorage
+func (p *Page) ClearSessionStorage() error {
+	return p.clearStorage(sessionStorageArea)
+}
+
+// getStorageItem reads key from the storage area of the page's origin
+func (p *Page) getStorageItem(area, key string) (string, error) {
+	res, err := p.EvalJS(`(area, key) => window[area].getItem(key)`, area, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s item %s: %w", area, key, err)
+	}
+
+	if res.obj.Value.Nil() {
+		return "", nil
+	}
+
+	return res.String(), nil
+}
+
+// setStorageItem writes key to the storage area of the page's origin
+func (p *Page) setStorageItem(area, key, value string) error {
+	if _, err := p.EvalJS(`(area, key, value) => window[area].setItem(key, value)`, area, key, value); err != nil {
+		return fmt.Errorf("failed to set %s item %s: %w", area, key, err)
+	}
+
+	return nil
+}
+
+// removeStorageItem deletes key from the storage area of the page's origin
+func (p *Page) removeStorageItem(area, key string) error {
+	if _, err := p.EvalJS(`(area, key) => window[area].removeItem(key)`, area, key); err != nil {
+		return fmt.Errorf("failed to remove %s item %s: %w", area, key, err)
+	}
+
+	return nil
+}
+
+// clearStorage empties the storage area of the page's origin
+func (p *Page) clearStorage(area string) error {
+	if _, err := p.EvalJS(`(area) => window[area].clear()`, area); err != nil {
+		return fmt.Errorf("failed to clear %s: %w", area, err)
+	}
+
+	return nil
+}
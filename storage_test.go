@@ -0,0 +1,119 @@
+package rodwer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// StorageTestSuite covers localStorage and sessionStorage helpers
+type StorageTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *StorageTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *StorageTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *StorageTestSuite) TestLocalStorage() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	// Storage is per origin, data: URLs have none
+	s.Require().NoError(page.Navigate(testServer.URL))
+
+	s.Require().NoError(page.SetLocalStorage("token", "abc123"))
+	s.Require().NoError(page.SetLocalStorage("flag", "on"))
+
+	s.Run("get stored value", func() {
+		value, err := page.GetLocalStorage("token")
+		s.Require().NoError(err)
+		s.Equal("abc123", value)
+
+		res, err := page.Evaluate(`() => localStorage.getItem('token')`)
+		s.Require().NoError(err)
+		s.Equal("abc123", res, "Value is visible to page scripts")
+	})
+
+	s.Run("missing key", func() {
+		value, err := page.GetLocalStorage("missing")
+		s.Require().NoError(err)
+		s.Empty(value)
+	})
+
+	s.Run("remove", func() {
+		s.Require().NoError(page.RemoveLocalStorage("token"))
+
+		value, err := page.GetLocalStorage("token")
+		s.Require().NoError(err)
+		s.Empty(value)
+
+		value, err = page.GetLocalStorage("flag")
+		s.Require().NoError(err)
+		s.Equal("on", value)
+	})
+
+	s.Run("clear", func() {
+		s.Require().NoError(page.ClearLocalStorage())
+
+		res, err := page.Evaluate(`() => localStorage.length`)
+		s.Require().NoError(err)
+		s.EqualValues(0, res)
+	})
+}
+
+func (s *StorageTestSuite) TestSessionStorage() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL))
+
+	s.Require().NoError(page.SetSessionStorage("step", "2"))
+
+	value, err := page.GetSessionStorage("step")
+	s.Require().NoError(err)
+	s.Equal("2", value)
+
+	local, err := page.GetLocalStorage("step")
+	s.Require().NoError(err)
+	s.Empty(local, "Session storage doesn't leak into localStorage")
+
+	s.Require().NoError(page.ClearSessionStorage())
+	value, err = page.GetSessionStorage("step")
+	s.Require().NoError(err)
+	s.Empty(value)
+}
+
+func (s *StorageTestSuite) TestClosedPage() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	s.Require().NoError(page.Close())
+
+	s.Error(page.SetLocalStorage("key", "value"))
+	_, err = page.GetLocalStorage("key")
+	s.Error(err)
+}
+
+// Run the storage test suite
+func TestStorageSuite(t *testing.T) {
+	suite.Run(t, new(StorageTestSuite))
+}
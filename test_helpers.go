@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"strings"
 	"time"
 )
@@ -209,3 +210,41 @@ func NewTestBrowser() (*Browser, func(), error) {
 
 	return browser, cleanup, nil
 }
+
+// FailureReporter is the part of testing.TB used by CaptureOnFailure, so the package doesn't import testing
+type FailureReporter interface {
+	Name() string
+	Failed() bool
+	Cleanup(func())
+	Logf(format string, args ...any)
+}
+
+// CaptureOnFailure saves a screenshot of page to dir when t has failed by the time its cleanups run.
+// The file is named after the test. Deferred calls run before cleanups, so close the page with
+// t.Cleanup registered before calling CaptureOnFailure rather than with defer.
+func CaptureOnFailure(t FailureReporter, page *Page, dir string) {
+	t.Cleanup(func() {
+		if !t.Failed() {
+			return
+		}
+
+		path := filepath.Join(dir, screenshotFileName(t.Name()))
+		if err := page.ScreenshotToFile(path); err != nil {
+			t.Logf("failed to capture failure screenshot: %v", err)
+			return
+		}
+		t.Logf("failure screenshot saved to %s", path)
+	})
+}
+
+// screenshotFileName turns a test name such as "TestSuite/case_1" into a file name
+func screenshotFileName(testName string) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ':' || r == ' ' {
+			return '_'
+		}
+		return r
+	}, testName)
+
+	return name + ".png"
+}
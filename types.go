@@ -2,11 +2,13 @@ package rodwer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-rod/rod"
@@ -23,6 +25,11 @@ type BrowserOptions struct {
 	Viewport       *Viewport
 	DevTools       bool
 	UserAgent      string
+	StealthLite    bool // hide the common automation markers: navigator.webdriver and the automation infobar
+	Proxy          *ProxyConfig
+	TrackNetwork   bool // record request stats for Page.NetworkSummary on pages created by NewPage
+	// NavigationTimeout bounds Navigate on every page of the browser, PageLoadTimeout when zero
+	NavigationTimeout time.Duration
 }
 
 // Viewport defines browser window dimensions
@@ -40,6 +47,13 @@ type Browser struct {
 	options  BrowserOptions
 	mu       sync.RWMutex
 	closed   bool
+	closing  bool
+	watched  bool           // set once ReconnectOnCrash monitors the browser process
+	ops      sync.WaitGroup // outstanding page operations, see CloseWait
+	handles  atomic.Int64   // open event listeners and remote objects across pages, see OpenHandles
+
+	downloadBehavior *proto.BrowserSetDownloadBehavior // last applied download behavior, restored after a relaunch
+	stealthTargets   map[proto.TargetTargetID]bool     // pages stealthLiteScript is installed on, see applyStealthLite
 }
 
 // Page represents a browser page/tab
@@ -50,6 +64,16 @@ type Page struct {
 	cancel  context.CancelFunc
 	mu      sync.RWMutex
 	closed  bool
+
+	coverageURLFilter urlFilter                              // set by StartJSCoverage, applied by StopJSCoverageWithWait
+	handles           atomic.Int64                           // open event listeners and remote objects, see OpenHandles
+	objects           map[proto.RuntimeRemoteObjectID]func() // tracked remote objects and the functions releasing their handle
+	networkPause      *networkPause                          // set while PauseNetwork holds requests
+	networkStats      *networkStats                          // set when BrowserOptions.TrackNetwork is enabled
+	routes            *pageRoutes                            // set while Route handlers are registered
+	fetch             *fetchInterceptor                      // shares the Fetch domain between interceptions, see fetch.go
+	dateMock          *dateMock                              // set by MockDate
+	extraHeaders      proto.NetworkHeaders                   // set by SetExtraHTTPHeaders, re-applied when a listener disables Network
 }
 
 // Element represents a DOM element
@@ -60,10 +84,14 @@ type Element struct {
 
 // ScreenshotOptions configures screenshot capture
 type ScreenshotOptions struct {
-	FullPage bool
-	Format   string // "png", "jpeg"
-	Quality  int    // for JPEG
-	Selector string // for element screenshots
+	FullPage   bool
+	Format     string // "png" (default), "jpeg" or "jpg"
+	Quality    int    // for JPEG
+	Selector   string // for element screenshots
+	RetryBlank bool   // retry captures smaller than MinScreenshotSize, e.g. taken before first paint
+	WaitImages bool   // for element screenshots, wait up to ImageLoadTimeout for its images to load
+
+	ScrollIntoView bool // for element screenshots, scroll the element into view first so it isn't clipped
 }
 
 // CoverageEntry represents JavaScript coverage data
@@ -71,6 +99,11 @@ type CoverageEntry struct {
 	URL    string
 	Source string
 	Ranges []CoverageRange
+
+	// Filled in by Summarize
+	LineCount        int
+	CoveredLineCount int
+	CoveredPercent   float64
 }
 
 // CoverageRange represents a coverage range
@@ -80,12 +113,19 @@ type CoverageRange struct {
 	Count int
 }
 
+// StartJSCoverageOptions configures which scripts JavaScript coverage is collected for
+type StartJSCoverageOptions struct {
+	URLFilter []string // glob patterns, "*" matches any characters; empty collects every script
+}
+
 // JSCoverageOptions configures JavaScript coverage collection behavior
 type JSCoverageOptions struct {
 	// Wait strategies for async JavaScript
 	WaitForAsyncFunctions bool          // Wait for setTimeout/setInterval/promises
 	AsyncWaitTimeout      time.Duration // Maximum time to wait for async operations
 	MinimumWaitTime       time.Duration // Minimum wait before taking coverage snapshot
+	StableCountThreshold  int           // Consecutive polls without newly parsed scripts before async code counts as settled, 0 waits a fixed delay
+	PollInterval          time.Duration // Time between parsed script count polls
 
 	// Page stability options
 	WaitForStability bool          // Wait for page to become stable
@@ -105,6 +145,8 @@ func DefaultCoverageOptions() JSCoverageOptions {
 		WaitForAsyncFunctions: true,
 		AsyncWaitTimeout:      2 * time.Second,
 		MinimumWaitTime:       500 * time.Millisecond,
+		StableCountThreshold:  3,
+		PollInterval:          200 * time.Millisecond,
 		WaitForStability:      true,
 		StabilityTimeout:      1 * time.Second,
 		EnableDebugLogs:       false,
@@ -115,14 +157,46 @@ func DefaultCoverageOptions() JSCoverageOptions {
 
 // NewBrowser creates a new browser instance
 func NewBrowser(options BrowserOptions) (*Browser, error) {
+	return NewBrowserWithContext(context.Background(), options)
+}
+
+// NewBrowserWithContext creates a new browser instance whose lifetime is bound to ctx,
+// cancelling ctx closes the browser
+func NewBrowserWithContext(ctx context.Context, options BrowserOptions) (*Browser, error) {
 	// Validate options first
 	if err := ValidateBrowserOptions(options); err != nil {
 		return nil, fmt.Errorf("invalid browser options: %w", err)
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to launch browser: %w", err)
+	}
+
+	browser, l, err := launchBrowser(options)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create context for browser lifecycle
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
+
+	b := &Browser{
+		browser:  browser,
+		launcher: l,
+		ctx:      ctx,
+		cancel:   cancel,
+		options:  options,
+	}
+
+	context.AfterFunc(ctx, func() {
+		_ = b.Close()
+	})
 
+	return b, nil
+}
+
+// launchBrowser starts a browser process configured by options and connects to it
+func launchBrowser(options BrowserOptions) (*rod.Browser, *launcher.Launcher, error) {
 	// Configure launcher
 	launcher := launcher.New()
 	launcher.Headless(options.Headless)
@@ -139,6 +213,15 @@ func NewBrowser(options BrowserOptions) (*Browser, error) {
 		launcher.Bin(options.ExecutablePath)
 	}
 
+	if options.Proxy != nil {
+		applyProxyConfig(launcher, options.Proxy)
+	}
+
+	if options.StealthLite {
+		launcher.Delete("enable-automation")
+		launcher.Set("disable-blink-features", "AutomationControlled")
+	}
+
 	// Add custom arguments
 	for _, arg := range options.Args {
 		launcher.Set("args", arg)
@@ -147,36 +230,22 @@ func NewBrowser(options BrowserOptions) (*Browser, error) {
 	// Launch browser
 	controlURL, err := launcher.Launch()
 	if err != nil {
-		cancel()
 		// Check if it's an executable not found error
 		if strings.Contains(err.Error(), "no such file or directory") && options.ExecutablePath != "" {
-			return nil, fmt.Errorf("executable not found: %s", options.ExecutablePath)
+			return nil, nil, fmt.Errorf("executable not found: %s", options.ExecutablePath)
 		}
-		return nil, fmt.Errorf("failed to launch browser: %w", err)
+		return nil, nil, fmt.Errorf("failed to launch browser: %w", err)
 	}
 
 	// Connect to browser
 	browser := rod.New().ControlURL(controlURL)
 	if err := browser.Connect(); err != nil {
-		cancel()
-		return nil, fmt.Errorf("failed to connect to browser: %w", err)
+		launcher.Kill()
+		launcher.Cleanup()
+		return nil, nil, fmt.Errorf("failed to connect to browser: %w", err)
 	}
 
-	// Configure browser settings
-	if options.UserAgent != "" {
-		// Set user agent for new pages
-		// Note: This will be applied to pages when they are created
-	}
-
-	b := &Browser{
-		browser:  browser,
-		launcher: launcher,
-		ctx:      ctx,
-		cancel:   cancel,
-		options:  options,
-	}
-
-	return b, nil
+	return browser, launcher, nil
 }
 
 // ValidateBrowserOptions validates browser options
@@ -197,13 +266,32 @@ func ValidateBrowserOptions(options BrowserOptions) error {
 		}
 	}
 
+	if options.Proxy != nil {
+		if err := validateProxyConfig(options.Proxy); err != nil {
+			return err
+		}
+	}
+
+	if options.NavigationTimeout < 0 {
+		return fmt.Errorf("navigation timeout must not be negative, got %s", options.NavigationTimeout)
+	}
+
 	return nil
 }
 
+// navigationTimeout returns the bound on page navigations
+func (b *Browser) navigationTimeout() time.Duration {
+	if b.options.NavigationTimeout > 0 {
+		return b.options.NavigationTimeout
+	}
+	return PageLoadTimeout
+}
+
 // NewPage creates a new page
 func (b *Browser) NewPage() (*Page, error) {
 	b.mu.RLock()
 	closed := b.closed
+	browser := b.browser
 	b.mu.RUnlock()
 
 	if closed {
@@ -211,7 +299,7 @@ func (b *Browser) NewPage() (*Page, error) {
 	}
 
 	// Create new page
-	rodPage, err := b.browser.Page(proto.TargetCreateTarget{})
+	rodPage, err := browser.Page(proto.TargetCreateTarget{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create page: %w", err)
 	}
@@ -228,6 +316,17 @@ func (b *Browser) NewPage() (*Page, error) {
 		}
 	}
 
+	// Apply the user agent before anything navigates
+	if err := b.applyUserAgent(rodPage); err != nil {
+		rodPage.MustClose()
+		return nil, err
+	}
+
+	if err := b.applyStealthLite(rodPage); err != nil {
+		rodPage.MustClose()
+		return nil, err
+	}
+
 	// Create page context
 	ctx, cancel := context.WithCancel(b.ctx)
 
@@ -238,6 +337,10 @@ func (b *Browser) NewPage() (*Page, error) {
 		cancel:  cancel,
 	}
 
+	if b.options.TrackNetwork {
+		page.trackNetwork()
+	}
+
 	return page, nil
 }
 
@@ -245,6 +348,7 @@ func (b *Browser) NewPage() (*Page, error) {
 func (b *Browser) Pages() ([]*Page, error) {
 	b.mu.RLock()
 	closed := b.closed
+	browser := b.browser
 	b.mu.RUnlock()
 
 	if closed {
@@ -252,7 +356,7 @@ func (b *Browser) Pages() ([]*Page, error) {
 	}
 
 	// Get all pages from browser
-	rodPages, err := b.browser.Pages()
+	rodPages, err := browser.Pages()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pages: %w", err)
 	}
@@ -260,6 +364,18 @@ func (b *Browser) Pages() ([]*Page, error) {
 	// Convert to our Page type
 	pages := make([]*Page, len(rodPages))
 	for i, rodPage := range rodPages {
+		err := b.applyUserAgent(rodPage)
+		if err == nil {
+			err = b.applyStealthLite(rodPage)
+		}
+		if err != nil {
+			// Release the contexts of the pages wrapped so far
+			for _, page := range pages[:i] {
+				page.cancel()
+			}
+			return nil, err
+		}
+
 		ctx, cancel := context.WithCancel(b.ctx)
 		pages[i] = &Page{
 			page:    rodPage,
@@ -272,6 +388,62 @@ func (b *Browser) Pages() ([]*Page, error) {
 	return pages, nil
 }
 
+// stealthLiteScript reports navigator.webdriver as false, see BrowserOptions.StealthLite
+const stealthLiteScript = `Object.defineProperty(Navigator.prototype, 'webdriver', { get: () => false, configurable: true });`
+
+// applyStealthLite installs stealthLiteScript for the page's future documents and runs it on the
+// current one when BrowserOptions.StealthLite is set. Each page gets the script only once.
+func (b *Browser) applyStealthLite(rodPage *rod.Page) error {
+	if !b.options.StealthLite {
+		return nil
+	}
+
+	b.mu.Lock()
+	if b.stealthTargets[rodPage.TargetID] {
+		b.mu.Unlock()
+		return nil
+	}
+	if b.stealthTargets == nil {
+		b.stealthTargets = make(map[proto.TargetTargetID]bool)
+	}
+	b.stealthTargets[rodPage.TargetID] = true
+	b.mu.Unlock()
+
+	forget := func() {
+		b.mu.Lock()
+		delete(b.stealthTargets, rodPage.TargetID)
+		b.mu.Unlock()
+	}
+
+	if _, err := rodPage.EvalOnNewDocument(stealthLiteScript); err != nil {
+		forget()
+		return fmt.Errorf("failed to install stealth script: %w", err)
+	}
+
+	// Adopted pages already show a document the script didn't run on
+	if _, err := rodPage.Evaluate(rod.Eval(`() => { ` + stealthLiteScript + ` }`)); err != nil {
+		return fmt.Errorf("failed to apply stealth script: %w", err)
+	}
+
+	return nil
+}
+
+// applyUserAgent overrides the page's user agent with BrowserOptions.UserAgent when set
+func (b *Browser) applyUserAgent(rodPage *rod.Page) error {
+	if b.options.UserAgent == "" {
+		return nil
+	}
+
+	err := rodPage.SetUserAgent(&proto.NetworkSetUserAgentOverride{
+		UserAgent: b.options.UserAgent,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set user agent: %w", err)
+	}
+
+	return nil
+}
+
 // Close closes the browser
 func (b *Browser) Close() error {
 	b.mu.Lock()
@@ -303,6 +475,50 @@ func (b *Browser) Close() error {
 	return nil
 }
 
+// CloseWait waits up to timeout for outstanding page operations to finish, then closes the browser
+func (b *Browser) CloseWait(timeout time.Duration) error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	// Stop tracking new operations so the wait group can drain
+	b.closing = true
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		b.ops.Wait()
+		close(done)
+	}()
+
+	var waitErr error
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		waitErr = fmt.Errorf("timeout waiting for page operations to finish after %v", timeout)
+	}
+
+	if err := b.Close(); err != nil {
+		return err
+	}
+
+	return waitErr
+}
+
+// trackOp registers a page operation with the browser and returns the function marking it done
+func (b *Browser) trackOp() func() {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed || b.closing {
+		return func() {}
+	}
+
+	b.ops.Add(1)
+	return b.ops.Done
+}
+
 // IsConnected returns connection status
 func (b *Browser) IsConnected() bool {
 	b.mu.RLock()
@@ -326,7 +542,7 @@ func (b *Browser) Context() context.Context {
 
 // Page interface methods
 
-// Navigate navigates to URL
+// Navigate navigates to URL and waits for the load event, bounded by BrowserOptions.NavigationTimeout
 func (p *Page) Navigate(url string) error {
 	p.mu.RLock()
 	closed := p.closed
@@ -336,13 +552,10 @@ func (p *Page) Navigate(url string) error {
 		return fmt.Errorf("page is closed")
 	}
 
-	if err := p.page.Navigate(url); err != nil {
-		return fmt.Errorf("failed to navigate to %s: %w", url, err)
-	}
+	// Track the operation so Browser.CloseWait can wait for it
+	defer p.browser.trackOp()()
 
-	// Wait for page to load
-	p.page.MustWaitLoad()
-	return nil
+	return p.navigate(p.ctx, url)
 }
 
 // Goto is an alias for Navigate (Playwright-style API)
@@ -360,18 +573,35 @@ func (p *Page) NavigateWithContext(ctx context.Context, url string) error {
 		return fmt.Errorf("page is closed")
 	}
 
-	// Use WithCancel to combine contexts
-	combinedCtx, cancel := context.WithCancel(ctx)
+	defer p.browser.trackOp()()
+
+	return p.navigate(ctx, url)
+}
+
+// navigate loads url and waits for the load event, bounded by ctx and the browser's navigation timeout
+func (p *Page) navigate(ctx context.Context, url string) error {
+	timeout := p.browser.navigationTimeout()
+	navCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	// Navigate with timeout
-	page := p.page.Context(combinedCtx)
+	err := loadURL(p.page.Context(navCtx), url)
+	if err != nil && ctx.Err() == nil && errors.Is(navCtx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timeout navigating to %s after %s: %w", url, timeout, err)
+	}
+
+	return err
+}
+
+// loadURL navigates page to url and waits for the load event
+func loadURL(page *rod.Page, url string) error {
 	if err := page.Navigate(url); err != nil {
 		return fmt.Errorf("failed to navigate to %s: %w", url, err)
 	}
 
-	// Wait for page to load with context
-	page.MustWaitLoad()
+	if err := page.WaitLoad(); err != nil {
+		return fmt.Errorf("failed to wait for %s to load: %w", url, err)
+	}
+
 	return nil
 }
 
@@ -426,10 +656,7 @@ func (p *Page) Element(selector string) (Element, error) {
 		return Element{}, fmt.Errorf("element not found: %s", selector)
 	}
 
-	return Element{
-		element: rodElement,
-		page:    p,
-	}, nil
+	return p.newElement(rodElement), nil
 }
 
 // Elements finds multiple elements by selector
@@ -449,17 +676,43 @@ func (p *Page) Elements(selector string) ([]Element, error) {
 
 	elements := make([]Element, len(rodElements))
 	for i, rodElement := range rodElements {
-		elements[i] = Element{
-			element: rodElement,
-			page:    p,
-		}
+		elements[i] = p.newElement(rodElement)
+	}
+
+	return elements, nil
+}
+
+// ElementsByCSSProperty returns the elements whose computed style for property equals value, in document order.
+// Custom properties such as "--theme" are supported; values are compared after trimming whitespace.
+func (p *Page) ElementsByCSSProperty(property, value string) ([]Element, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	defer p.browser.trackOp()()
+
+	rodElements, err := p.page.Context(p.ctx).ElementsByJS(rod.Eval(`(property, value) => {
+		return Array.from(document.querySelectorAll('*')).filter(el =>
+			getComputedStyle(el).getPropertyValue(property).trim() === value.trim());
+	}`, property, value))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find elements with %s: %s: %w", property, value, err)
+	}
+
+	elements := make([]Element, len(rodElements))
+	for i, rodElement := range rodElements {
+		elements[i] = p.newElement(rodElement)
 	}
 
 	return elements, nil
 }
 
 // WaitForElement waits for element to appear
-func (p *Page) WaitForElement(selector string, timeout time.Duration) (Element, error) {
+func (p *Page) WaitForElement(selector string, timeout time.Duration, opts ...WaitForElementOptions) (Element, error) {
 	p.mu.RLock()
 	closed := p.closed
 	p.mu.RUnlock()
@@ -468,6 +721,10 @@ func (p *Page) WaitForElement(selector string, timeout time.Duration) (Element,
 		return Element{}, fmt.Errorf("page is closed")
 	}
 
+	if len(opts) > 0 && opts[0].SurviveNavigation {
+		return p.waitForElementAcrossNavigation(selector, timeout)
+	}
+
 	// Create timeout context
 	ctx, cancel := context.WithTimeout(p.ctx, timeout)
 	defer cancel()
@@ -485,6 +742,8 @@ func (p *Page) WaitForElementWithContext(ctx context.Context, selector string) (
 		return Element{}, fmt.Errorf("page is closed")
 	}
 
+	defer p.browser.trackOp()()
+
 	// Use Rod's wait functionality with timeout
 	rodElement, err := p.page.Timeout(5 * time.Second).Element(selector)
 	if err != nil {
@@ -494,10 +753,7 @@ func (p *Page) WaitForElementWithContext(ctx context.Context, selector string) (
 		return Element{}, fmt.Errorf("element not found: %s", selector)
 	}
 
-	return Element{
-		element: rodElement,
-		page:    p,
-	}, nil
+	return p.newElement(rodElement), nil
 }
 
 // Screenshot captures page screenshot
@@ -510,12 +766,16 @@ func (p *Page) Screenshot(options ScreenshotOptions) ([]byte, error) {
 		return nil, fmt.Errorf("page is closed")
 	}
 
+	defer p.browser.trackOp()()
+
 	// Handle element screenshot
 	if options.Selector != "" {
 		element, err := p.Element(options.Selector)
 		if err != nil {
 			return nil, fmt.Errorf("failed to find element for screenshot: %w", err)
 		}
+		defer func() { _ = element.Release() }()
+
 		return p.screenshotElement(element, options)
 	}
 
@@ -566,11 +826,44 @@ func (p *Page) ScreenshotSimpleToFile(filePath string) error {
 	return p.ScreenshotToFile(filePath)
 }
 
+// ScreenshotAndSave captures a screenshot and writes it to savePath only when saveCondition accepts the data.
+// A nil saveCondition always saves. The screenshot is returned either way.
+func (p *Page) ScreenshotAndSave(opts ScreenshotOptions, savePath string, saveCondition func([]byte) bool) ([]byte, error) {
+	if savePath == "" {
+		return nil, fmt.Errorf("file path cannot be empty")
+	}
+
+	if opts.Format == "" {
+		opts.Format = detectFormatFromExtension(savePath)
+	}
+
+	data, err := p.Screenshot(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take screenshot: %w", err)
+	}
+
+	if saveCondition != nil && !saveCondition(data) {
+		return data, nil
+	}
+
+	if err := writeScreenshotToFile(savePath, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
 // StartJSCoverage starts JavaScript coverage collection
-func (p *Page) StartJSCoverage() error {
-	p.mu.RLock()
+func (p *Page) StartJSCoverage(options ...StartJSCoverageOptions) error {
+	p.mu.Lock()
 	closed := p.closed
-	p.mu.RUnlock()
+	if !closed {
+		p.coverageURLFilter = nil
+		if len(options) > 0 {
+			p.coverageURLFilter = compileURLFilter(options[0].URLFilter)
+		}
+	}
+	p.mu.Unlock()
 
 	if closed {
 		return fmt.Errorf("page is closed")
@@ -609,12 +902,15 @@ func (p *Page) StopJSCoverage() ([]CoverageEntry, error) {
 func (p *Page) StopJSCoverageWithWait(options JSCoverageOptions) ([]CoverageEntry, error) {
 	p.mu.RLock()
 	closed := p.closed
+	urlFilter := p.coverageURLFilter
 	p.mu.RUnlock()
 
 	if closed {
 		return nil, fmt.Errorf("page is closed")
 	}
 
+	defer p.browser.trackOp()()
+
 	if options.EnableDebugLogs {
 		fmt.Printf("[DEBUG] Starting enhanced coverage collection with options: %+v\n", options)
 	}
@@ -628,7 +924,17 @@ func (p *Page) StopJSCoverageWithWait(options JSCoverageOptions) ([]CoverageEntr
 	}
 
 	// Wait for async JavaScript functions if enabled
-	if options.WaitForAsyncFunctions {
+	if options.WaitForAsyncFunctions && options.StableCountThreshold > 0 {
+		if options.EnableDebugLogs {
+			fmt.Printf("[DEBUG] Waiting for %d polls without new scripts (timeout: %v)...\n", options.StableCountThreshold, options.AsyncWaitTimeout)
+		}
+
+		settled := p.waitForStableScriptCount(options)
+
+		if options.EnableDebugLogs {
+			fmt.Printf("[DEBUG] Async wait completed, settled: %v\n", settled)
+		}
+	} else if options.WaitForAsyncFunctions {
 		// Simple wait instead of complex detection to avoid script errors
 		if options.EnableDebugLogs {
 			fmt.Printf("[DEBUG] Waiting for async JavaScript (simple delay: %v)...\n", options.AsyncWaitTimeout)
@@ -696,45 +1002,63 @@ func (p *Page) StopJSCoverageWithWait(options JSCoverageOptions) ([]CoverageEntr
 	}
 
 	// Convert to our coverage format
-	coverageEntries := make([]CoverageEntry, 0)
-
-	for _, script := range result.Result {
-		// Get script source
-		srcResp, err := proto.DebuggerGetScriptSource{ScriptID: script.ScriptID}.Call(p.page)
-		if err != nil || srcResp.ScriptSource == "" {
-			continue // Skip scripts without source
+	coverageEntries := collectCoverageEntries(result.Result, urlFilter, func(id proto.RuntimeScriptID) (string, error) {
+		res, err := proto.DebuggerGetScriptSource{ScriptID: id}.Call(p.page)
+		if err != nil {
+			return "", err
 		}
+		return res.ScriptSource, nil
+	})
 
-		// Collect all ranges from all functions
-		ranges := make([]CoverageRange, 0)
-		for _, fn := range script.Functions {
-			for _, r := range fn.Ranges {
-				ranges = append(ranges, CoverageRange{
-					Start: r.StartOffset,
-					End:   r.EndOffset,
-					Count: r.Count,
-				})
-			}
-		}
+	if options.EnableDebugLogs {
+		fmt.Printf("[DEBUG] Coverage collection complete: %d entries\n", len(coverageEntries))
+	}
 
-		// Handle empty URLs for inline scripts or data URLs
-		url := script.URL
-		if url == "" {
-			url = fmt.Sprintf("inline-script-%s", script.ScriptID)
-		}
+	return coverageEntries, nil
+}
 
-		coverageEntries = append(coverageEntries, CoverageEntry{
-			URL:    url,
-			Source: srcResp.ScriptSource,
-			Ranges: ranges,
-		})
+// waitForStableScriptCount waits until no script was parsed for options.StableCountThreshold polls,
+// at most options.AsyncWaitTimeout, and reports whether the count settled
+func (p *Page) waitForStableScriptCount(options JSCoverageOptions) bool {
+	ctx, cancel := context.WithCancel(p.ctx)
+	defer cancel()
+
+	var parsed atomic.Int64
+	wait := p.page.Context(ctx).EachEvent(func(e *proto.DebuggerScriptParsed) {
+		parsed.Add(1)
+	})
+	p.listen(wait)
+
+	interval := options.PollInterval
+	if interval <= 0 {
+		interval = StabilityPollInterval
 	}
 
-	if options.EnableDebugLogs {
-		fmt.Printf("[DEBUG] Coverage collection complete: %d entries\n", len(coverageEntries))
+	return waitForStableCount(parsed.Load, options.StableCountThreshold, interval, options.AsyncWaitTimeout)
+}
+
+// waitForStableCount polls count every interval until it stays unchanged for threshold consecutive polls.
+// It returns false when timeout passes first.
+func waitForStableCount(count func() int64, threshold int, interval, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+
+	last, stable := count(), 0
+	for stable < threshold {
+		if time.Now().Add(interval).After(deadline) {
+			return false
+		}
+
+		time.Sleep(interval)
+
+		current := count()
+		if current == last {
+			stable++
+		} else {
+			last, stable = current, 0
+		}
 	}
 
-	return coverageEntries, nil
+	return true
 }
 
 // Close closes the page
@@ -748,6 +1072,9 @@ func (p *Page) Close() error {
 
 	p.closed = true
 
+	// Remote objects die with the page
+	p.releaseObjects()
+
 	// Cancel context first
 	if p.cancel != nil {
 		p.cancel()
@@ -772,25 +1099,57 @@ func (p *Page) Context() context.Context {
 
 // Element interface methods
 
-// Click clicks the element
+// Click scrolls the element into view and clicks it
 func (e Element) Click() error {
-	if e.element == nil {
-		return fmt.Errorf("element is nil")
+	return e.ClickButton(proto.InputMouseButtonLeft, 1)
+}
+
+// ClickButton scrolls the element into view and clicks its center with button, count times in one sequence
+func (e Element) ClickButton(button proto.InputMouseButton, count int) error {
+	if err := e.checkUsable(); err != nil {
+		return err
+	}
+
+	if count < 1 {
+		return fmt.Errorf("click count must be positive, got %d", count)
 	}
 
-	if err := e.element.Click(proto.InputMouseButtonLeft, 1); err != nil {
+	// rod's Click scrolls the element into view itself
+	if err := e.element.Click(button, count); err != nil {
 		return fmt.Errorf("failed to click element: %w", err)
 	}
 
 	return nil
 }
 
-// Type types text into the element
+// moveCaretToEnd focuses the element and collapses the selection after its existing text
+const moveCaretToEnd = `() => {
+	this.focus();
+	if (typeof this.setSelectionRange === 'function' && typeof this.value === 'string') {
+		// Inputs such as email or number don't support selection, typing goes where the browser puts the caret
+		try { this.setSelectionRange(this.value.length, this.value.length); } catch (e) {}
+		return;
+	}
+	if (this.isContentEditable) {
+		const range = document.createRange();
+		range.selectNodeContents(this);
+		range.collapse(false);
+		const selection = window.getSelection();
+		selection.removeAllRanges();
+		selection.addRange(range);
+	}
+}`
+
+// Type types text into the element after its existing content
 func (e Element) Type(text string) error {
 	if e.element == nil {
 		return fmt.Errorf("element is nil")
 	}
 
+	if _, err := e.element.Eval(moveCaretToEnd); err != nil {
+		return fmt.Errorf("failed to move caret to end: %w", err)
+	}
+
 	if err := e.element.Input(text); err != nil {
 		return fmt.Errorf("failed to type text: %w", err)
 	}
@@ -803,7 +1162,20 @@ func (e Element) Fill(text string) error {
 	return e.Type(text)
 }
 
-// Clear clears the element content
+// clearWithNativeSetter empties a form control through the prototype's value setter and fires input and change,
+// which controlled inputs that reject or restore keyboard edits pick up as a user change
+const clearWithNativeSetter = `() => {
+	if (!('value' in this)) return '';
+	const proto = Object.getPrototypeOf(this);
+	const setter = Object.getOwnPropertyDescriptor(proto, 'value')?.set;
+	if (setter) setter.call(this, ''); else this.value = '';
+	this.dispatchEvent(new Event('input', { bubbles: true }));
+	this.dispatchEvent(new Event('change', { bubbles: true }));
+	return this.value;
+}`
+
+// Clear clears the element content and verifies it is empty afterwards.
+// When a controlled input keeps its text, the value is cleared again with the native setter.
 func (e Element) Clear() error {
 	if e.element == nil {
 		return fmt.Errorf("element is nil")
@@ -818,11 +1190,32 @@ func (e Element) Clear() error {
 		return fmt.Errorf("failed to clear element: %w", err)
 	}
 
+	res, err := e.element.Eval(`() => 'value' in this ? this.value : ''`)
+	if err != nil {
+		return fmt.Errorf("failed to verify element is cleared: %w", err)
+	}
+	if res.Value.Str() == "" {
+		return nil
+	}
+
+	res, err = e.element.Eval(clearWithNativeSetter)
+	if err != nil {
+		return fmt.Errorf("failed to clear element: %w", err)
+	}
+	if remaining := res.Value.Str(); remaining != "" {
+		return fmt.Errorf("element still has value %q after clear", remaining)
+	}
+
 	return nil
 }
 
-// Text returns element text content
-func (e Element) Text() (string, error) {
+// TextOptions configures Element.Text
+type TextOptions struct {
+	TrimSpace bool // strip leading and trailing whitespace
+}
+
+// Text returns the element's rendered text, like InnerText, or the value of inputs and textareas
+func (e Element) Text(opts ...TextOptions) (string, error) {
 	if e.element == nil {
 		return "", fmt.Errorf("element is nil")
 	}
@@ -832,6 +1225,10 @@ func (e Element) Text() (string, error) {
 		return "", fmt.Errorf("failed to get text: %w", err)
 	}
 
+	if len(opts) > 0 && opts[0].TrimSpace {
+		text = strings.TrimSpace(text)
+	}
+
 	return text, nil
 }
 
@@ -867,15 +1264,18 @@ func (e Element) TagName() (string, error) {
 	return val.String(), nil
 }
 
-// Screenshot takes a screenshot of the element
-func (e Element) Screenshot() ([]byte, error) {
+// Screenshot takes a screenshot of the element, a PNG unless opts sets another format
+func (e Element) Screenshot(opts ...ScreenshotOptions) ([]byte, error) {
 	if e.element == nil {
 		return nil, fmt.Errorf("element is nil")
 	}
 
-	return e.page.screenshotElement(e, ScreenshotOptions{
-		Format: "png",
-	})
+	options := ScreenshotOptions{Format: "png"}
+	if len(opts) > 0 {
+		options = opts[0]
+	}
+
+	return e.page.screenshotElement(e, options)
 }
 
 // ScreenshotToFile takes a screenshot of the element and saves directly to file
@@ -917,11 +1317,23 @@ func fileExists(filename string) bool {
 	return err == nil
 }
 
+// waitImages waits up to timeout for the element's images to finish loading.
+// Images still loading afterwards are captured as they are.
+func (e Element) waitImages(timeout time.Duration) {
+	_ = e.page.waitUntil(timeout, func(page *rod.Page) bool {
+		res, err := e.element.Context(page.GetContext()).Eval(`() => {
+			const images = this.matches('img') ? [this] : Array.from(this.querySelectorAll('img'));
+			return images.every(img => img.complete);
+		}`)
+		return err == nil && res.Value.Bool()
+	})
+}
+
 // screenshotPage captures a full page or viewport screenshot
 func (p *Page) screenshotPage(options ScreenshotOptions) ([]byte, error) {
-	format := proto.PageCaptureScreenshotFormatPng
-	if strings.ToLower(options.Format) == "jpeg" {
-		format = proto.PageCaptureScreenshotFormatJpeg
+	format, err := screenshotFormat(options.Format)
+	if err != nil {
+		return nil, err
 	}
 
 	// Configure screenshot request
@@ -940,12 +1352,12 @@ func (p *Page) screenshotPage(options ScreenshotOptions) ([]byte, error) {
 	}
 
 	// Take screenshot
-	result, err := req.Call(p.page)
+	data, err := p.capture(req, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to capture screenshot: %w", err)
 	}
 
-	return result.Data, nil
+	return data, nil
 }
 
 // screenshotElement captures a screenshot of a specific element
@@ -954,50 +1366,36 @@ func (p *Page) screenshotElement(element Element, options ScreenshotOptions) ([]
 		return nil, fmt.Errorf("element is nil")
 	}
 
-	format := proto.PageCaptureScreenshotFormatPng
-	if strings.ToLower(options.Format) == "jpeg" {
-		format = proto.PageCaptureScreenshotFormatJpeg
-	}
-
-	// Get element bounds
-	box, err := element.element.Shape()
+	format, err := screenshotFormat(options.Format)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get element bounds: %w", err)
+		return nil, err
 	}
 
-	if len(box.Quads) == 0 {
-		return nil, fmt.Errorf("element has no quads")
+	// Elements below the fold would be clipped out of the capture
+	if options.ScrollIntoView {
+		if err := element.ScrollIntoView(); err != nil {
+			return nil, err
+		}
 	}
 
-	quad := box.Quads[0]
-
-	// Calculate bounding box
-	minX, maxX := quad[0], quad[0]
-	minY, maxY := quad[1], quad[1]
+	if options.WaitImages {
+		element.waitImages(ImageLoadTimeout)
+	}
 
-	for i := 0; i < len(quad); i += 2 {
-		if quad[i] < minX {
-			minX = quad[i]
-		}
-		if quad[i] > maxX {
-			maxX = quad[i]
-		}
-		if quad[i+1] < minY {
-			minY = quad[i+1]
-		}
-		if quad[i+1] > maxY {
-			maxY = quad[i+1]
-		}
+	// Get element bounds
+	box, err := element.viewportBox()
+	if err != nil {
+		return nil, err
 	}
 
 	// Configure screenshot request
 	req := &proto.PageCaptureScreenshot{
 		Format: format,
 		Clip: &proto.PageViewport{
-			X:      minX,
-			Y:      minY,
-			Width:  maxX - minX,
-			Height: maxY - minY,
+			X:      box.X,
+			Y:      box.Y,
+			Width:  box.Width,
+			Height: box.Height,
 			Scale:  1,
 		},
 	}
@@ -1008,12 +1406,12 @@ func (p *Page) screenshotElement(element Element, options ScreenshotOptions) ([]
 	}
 
 	// Take screenshot
-	result, err := req.Call(p.page)
+	data, err := p.capture(req, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to capture element screenshot: %w", err)
 	}
 
-	return result.Data, nil
+	return data, nil
 }
 
 // Helper functions for ScreenshotToFile methods
@@ -0,0 +1,162 @@
+package rodwer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// VisualSuiteOptions configures a VisualRegressionSuite
+type VisualSuiteOptions struct {
+	Threshold      float64 // fraction of pixels allowed to differ, 0 requires an exact match
+	ColorTolerance uint8   // per channel difference still treated as equal, absorbs anti-aliasing noise
+	FullPage       bool    // capture the full page instead of the viewport
+}
+
+// VisualRegressionSuite compares page screenshots against baseline PNGs stored in a directory
+type VisualRegressionSuite struct {
+	baselineDir string
+	opts        VisualSuiteOptions
+}
+
+// VisualDiff is the result of comparing a screenshot with its baseline
+type VisualDiff struct {
+	Name         string
+	BaselinePath string
+	NewBaseline  bool    // no baseline existed, the screenshot was stored as the baseline
+	SizeMismatch bool    // screenshot and baseline dimensions differ
+	DiffPixels   int     // pixels differing by more than ColorTolerance
+	TotalPixels  int     // pixels compared
+	DiffRatio    float64 // DiffPixels / TotalPixels
+	Match        bool    // DiffRatio is within Threshold and the sizes match
+}
+
+// NewVisualRegressionSuite creates a suite keeping its baselines in baselineDir
+func NewVisualRegressionSuite(baselineDir string, opts VisualSuiteOptions) *VisualRegressionSuite {
+	return &VisualRegressionSuite{
+		baselineDir: baselineDir,
+		opts:        opts,
+	}
+}
+
+// Check screenshots page and compares it with the baseline called name.
+// A missing baseline is created from the screenshot and reported as a match.
+func (s *VisualRegressionSuite) Check(page *Page, name string) (*VisualDiff, error) {
+	path, err := s.baselinePath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, err := s.capture(page)
+	if err != nil {
+		return nil, err
+	}
+
+	baseline, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if err := writeScreenshotToFile(path, actual); err != nil {
+			return nil, err
+		}
+		return &VisualDiff{Name: name, BaselinePath: path, NewBaseline: true, Match: true}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline %s: %w", path, err)
+	}
+
+	diff, err := comparePNG(baseline, actual, s.opts.ColorTolerance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare %s with its baseline: %w", name, err)
+	}
+
+	diff.Name = name
+	diff.BaselinePath = path
+	diff.Match = !diff.SizeMismatch && diff.DiffRatio <= s.opts.Threshold
+
+	return diff, nil
+}
+
+// UpdateBaseline screenshots page and overwrites the baseline called name
+func (s *VisualRegressionSuite) UpdateBaseline(page *Page, name string) error {
+	path, err := s.baselinePath(name)
+	if err != nil {
+		return err
+	}
+
+	data, err := s.capture(page)
+	if err != nil {
+		return err
+	}
+
+	return writeScreenshotToFile(path, data)
+}
+
+// baselinePath returns the file of the baseline called name
+func (s *VisualRegressionSuite) baselinePath(name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid baseline name %q", name)
+	}
+
+	return filepath.Join(s.baselineDir, name+".png"), nil
+}
+
+// capture takes the PNG screenshot compared against baselines
+func (s *VisualRegressionSuite) capture(page *Page) ([]byte, error) {
+	data, err := page.Screenshot(ScreenshotOptions{FullPage: s.opts.FullPage, Format: "png", RetryBlank: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to take screenshot: %w", err)
+	}
+
+	return data, nil
+}
+
+// comparePNG counts the pixels of two PNG images differing by more than tolerance in any channel
+func comparePNG(baseline, actual []byte, tolerance uint8) (*VisualDiff, error) {
+	a, err := png.Decode(bytes.NewReader(baseline))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode baseline: %w", err)
+	}
+
+	b, err := png.Decode(bytes.NewReader(actual))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode screenshot: %w", err)
+	}
+
+	if a.Bounds().Size() != b.Bounds().Size() {
+		return &VisualDiff{SizeMismatch: true, DiffRatio: 1}, nil
+	}
+
+	size := a.Bounds().Size()
+	diff := &VisualDiff{TotalPixels: size.X * size.Y}
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			if !pixelsEqual(a, b, image.Pt(x, y), tolerance) {
+				diff.DiffPixels++
+			}
+		}
+	}
+
+	if diff.TotalPixels > 0 {
+		diff.DiffRatio = float64(diff.DiffPixels) / float64(diff.TotalPixels)
+	}
+
+	return diff, nil
+}
+
+// pixelsEqual compares the pixel at offset pt from each image's origin
+func pixelsEqual(a, b image.Image, pt image.Point, tolerance uint8) bool {
+	r1, g1, b1, a1 := a.At(a.Bounds().Min.X+pt.X, a.Bounds().Min.Y+pt.Y).RGBA()
+	r2, g2, b2, a2 := b.At(b.Bounds().Min.X+pt.X, b.Bounds().Min.Y+pt.Y).RGBA()
+
+	within := func(c1, c2 uint32) bool {
+		// RGBA returns 16 bit channels, compare at 8 bit
+		d := int(c1>>8) - int(c2>>8)
+		return d <= int(tolerance) && -d <= int(tolerance)
+	}
+
+	return within(r1, r2) && within(g1, g2) && within(b1, b2) && within(a1, a2)
+}
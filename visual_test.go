@@ -0,0 +1,161 @@
+package rodwer
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// VisualTestSuite covers baseline screenshot comparison
+type VisualTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *VisualTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *VisualTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *VisualTestSuite) TestVisualRegressionSuite() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	render := func(color string) {
+		err := page.SetContent(`<html><body style="margin: 0; background: ` + color + `"><h1>Visual</h1></body></html>`)
+		s.Require().NoError(err)
+	}
+
+	dir := s.T().TempDir()
+	visual := NewVisualRegressionSuite(dir, VisualSuiteOptions{})
+	render("white")
+
+	s.Run("first check creates the baseline", func() {
+		diff, err := visual.Check(page, "home")
+		s.Require().NoError(err)
+		s.True(diff.NewBaseline)
+		s.True(diff.Match)
+		s.FileExists(filepath.Join(dir, "home.png"))
+	})
+
+	s.Run("second check has no diff", func() {
+		diff, err := visual.Check(page, "home")
+		s.Require().NoError(err)
+		s.False(diff.NewBaseline)
+		s.True(diff.Match)
+		s.Zero(diff.DiffPixels)
+		s.Positive(diff.TotalPixels)
+	})
+
+	s.Run("changed page is reported", func() {
+		render("red")
+
+		diff, err := visual.Check(page, "home")
+		s.Require().NoError(err)
+		s.False(diff.Match)
+		s.Greater(diff.DiffRatio, 0.5)
+	})
+
+	s.Run("update overwrites the baseline", func() {
+		before, err := os.ReadFile(filepath.Join(dir, "home.png"))
+		s.Require().NoError(err)
+
+		s.Require().NoError(visual.UpdateBaseline(page, "home"))
+
+		after, err := os.ReadFile(filepath.Join(dir, "home.png"))
+		s.Require().NoError(err)
+		s.NotEqual(before, after)
+
+		diff, err := visual.Check(page, "home")
+		s.Require().NoError(err)
+		s.True(diff.Match)
+	})
+
+	s.Run("invalid names", func() {
+		_, err := visual.Check(page, "../escape")
+		s.Error(err)
+		s.Error(visual.UpdateBaseline(page, ""))
+	})
+}
+
+func TestComparePNG(t *testing.T) {
+	encode := func(w, h int, fill func(x, y int) color.Color) []byte {
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				img.Set(x, y, fill(x, y))
+			}
+		}
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, img))
+		return buf.Bytes()
+	}
+
+	gray := func(x, y int) color.Color { return color.RGBA{100, 100, 100, 255} }
+	baseline := encode(4, 4, gray)
+
+	t.Run("identical images", func(t *testing.T) {
+		diff, err := comparePNG(baseline, encode(4, 4, gray), 0)
+		require.NoError(t, err)
+		assert.Equal(t, 0, diff.DiffPixels)
+		assert.Equal(t, 16, diff.TotalPixels)
+	})
+
+	t.Run("changed pixels are counted", func(t *testing.T) {
+		diff, err := comparePNG(baseline, encode(4, 4, func(x, y int) color.Color {
+			if y == 0 {
+				return color.RGBA{255, 0, 0, 255}
+			}
+			return gray(x, y)
+		}), 0)
+		require.NoError(t, err)
+		assert.Equal(t, 4, diff.DiffPixels)
+		assert.Equal(t, 0.25, diff.DiffRatio)
+	})
+
+	t.Run("tolerance absorbs small differences", func(t *testing.T) {
+		noisy := encode(4, 4, func(x, y int) color.Color { return color.RGBA{103, 98, 100, 255} })
+
+		diff, err := comparePNG(baseline, noisy, 3)
+		require.NoError(t, err)
+		assert.Equal(t, 0, diff.DiffPixels)
+
+		diff, err = comparePNG(baseline, noisy, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 16, diff.DiffPixels)
+	})
+
+	t.Run("size mismatch", func(t *testing.T) {
+		diff, err := comparePNG(baseline, encode(4, 5, gray), 0)
+		require.NoError(t, err)
+		assert.True(t, diff.SizeMismatch)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		_, err := comparePNG(baseline, []byte("not a png"), 0)
+		assert.Error(t, err)
+	})
+}
+
+// Run the visual test suite
+func TestVisualSuite(t *testing.T) {
+	suite.Run(t, new(VisualTestSuite))
+}
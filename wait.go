@@ -0,0 +1,176 @@
+package rodwer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/ysmood/gson"
+)
+
+// WaitForElementOptions configures WaitForElement
+type WaitForElementOptions struct {
+	SurviveNavigation bool // keep waiting on the new document when the page navigates or reloads mid-wait
+}
+
+// waitForElementAcrossNavigation polls for selector on whichever document the page currently shows
+func (p *Page) waitForElementAcrossNavigation(selector string, timeout time.Duration) (Element, error) {
+	var found *rod.Element
+	err := p.waitUntil(timeout, func(page *rod.Page) bool {
+		// Errors from a document being torn down just mean the next poll looks at the new one
+		has, el, err := page.Has(selector)
+		if err != nil || !has {
+			return false
+		}
+		found = el
+		return true
+	})
+	if err != nil {
+		return Element{}, fmt.Errorf("timeout waiting for element %s: %w", selector, err)
+	}
+
+	return p.newElement(found.Context(p.ctx)), nil
+}
+
+// WaitForAttributeGone waits until the element matching selector no longer has the attribute
+func (p *Page) WaitForAttributeGone(selector, attr string, timeout time.Duration) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	err := p.waitUntil(timeout, func(page *rod.Page) bool {
+		res, err := page.Eval(`(s, a) => {
+			const el = document.querySelector(s);
+			return el !== null && !el.hasAttribute(a);
+		}`, selector, attr)
+		return err == nil && res.Value.Bool()
+	})
+	if err != nil {
+		return fmt.Errorf("timeout waiting for attribute %s to be removed from %s: %w", attr, selector, err)
+	}
+
+	return nil
+}
+
+// WaitForFunction polls a JavaScript expression or function at ElementPollInterval until it returns a truthy value
+func (p *Page) WaitForFunction(expression string, timeout time.Duration, args ...interface{}) (*EvalResult, error) {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return nil, fmt.Errorf("page is closed")
+	}
+
+	var last *EvalResult
+	err := p.waitUntil(timeout, func(page *rod.Page) bool {
+		res, err := evalJS(page, expression, args...)
+		if err != nil {
+			return false
+		}
+		last = res
+		return isTruthy(res.obj.Value)
+	})
+	if err != nil {
+		lastValue := "no value"
+		if last != nil {
+			lastValue = last.obj.Value.JSON("", "")
+		}
+		return nil, fmt.Errorf("timeout waiting for %q to be truthy (last value: %s): %w", expression, lastValue, err)
+	}
+
+	return last, nil
+}
+
+// WaitForURL polls the page URL at ElementPollInterval until it matches pattern.
+// pattern is an exact URL, a glob such as "**/admin/**", or a regexp wrapped in slashes such as "/user/\d+$/".
+func (p *Page) WaitForURL(pattern string, timeout time.Duration) error {
+	p.mu.RLock()
+	closed := p.closed
+	p.mu.RUnlock()
+
+	if closed {
+		return fmt.Errorf("page is closed")
+	}
+
+	match, err := urlMatcher(pattern)
+	if err != nil {
+		return err
+	}
+
+	var last string
+	err = p.waitUntil(timeout, func(*rod.Page) bool {
+		last = p.URL()
+		return match(last)
+	})
+	if err != nil {
+		return fmt.Errorf("timeout waiting for URL to match %q (last URL: %s): %w", pattern, last, err)
+	}
+
+	return nil
+}
+
+// urlMatcher compiles a WaitForURL pattern into a match function
+func urlMatcher(pattern string) (func(string) bool, error) {
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid URL pattern %q: %w", pattern, err)
+		}
+		return re.MatchString, nil
+	}
+
+	if strings.Contains(pattern, "*") {
+		return globToRegexp(pattern).MatchString, nil
+	}
+
+	return func(url string) bool { return url == pattern }, nil
+}
+
+// isTruthy applies JavaScript truthiness to an evaluated value
+func isTruthy(value gson.JSON) bool {
+	switch v := value.Val().(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	default:
+		return true
+	}
+}
+
+// waitUntil polls check at ElementPollInterval until it returns true or the timeout elapses
+func (p *Page) waitUntil(timeout time.Duration, check func(page *rod.Page) bool) error {
+	defer p.browser.trackOp()()
+
+	ctx, cancel := context.WithTimeout(p.ctx, timeout)
+	defer cancel()
+
+	page := p.page.Context(ctx)
+
+	ticker := time.NewTicker(ElementPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if check(page) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
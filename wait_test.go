@@ -0,0 +1,231 @@
+package rodwer
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/ysmood/gson"
+)
+
+// WaitTestSuite covers the polling wait helpers on Page
+type WaitTestSuite struct {
+	suite.Suite
+	browser   *Browser
+	cleanupFn func()
+}
+
+func (s *WaitTestSuite) SetupSuite() {
+	browser, cleanup, err := NewTestBrowser()
+	s.Require().NoError(err, "Failed to create test browser")
+	s.browser = browser
+	s.cleanupFn = cleanup
+}
+
+func (s *WaitTestSuite) TearDownSuite() {
+	if s.cleanupFn != nil {
+		s.cleanupFn()
+	}
+}
+
+func (s *WaitTestSuite) TestWaitForAttributeGone() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	html := `
+	<html>
+	<body>
+		<button id="save" disabled>Save</button>
+		<div id="spinner" aria-busy="true"></div>
+		<script>
+			setTimeout(function() {
+				document.getElementById('save').removeAttribute('disabled');
+			}, 300);
+		</script>
+	</body>
+	</html>`
+
+	err = page.Navigate("data:text/html," + html)
+	s.Require().NoError(err)
+
+	s.Run("attribute removed by script", func() {
+		err := page.WaitForAttributeGone("#save", "disabled", 3*time.Second)
+		s.NoError(err)
+	})
+
+	s.Run("timeout when attribute stays", func() {
+		err := page.WaitForAttributeGone("#spinner", "aria-busy", 200*time.Millisecond)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "timeout")
+		s.Contains(err.Error(), "#spinner")
+		s.Contains(err.Error(), "aria-busy")
+	})
+}
+
+func (s *WaitTestSuite) TestWaitForElementSurvivesNavigation() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	testServer.AddRoute("/login", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body>
+			<h1>Signing in</h1>
+			<script>setTimeout(function() { location.href = '/dashboard'; }, 300);</script>
+		</body></html>`))
+	})
+	testServer.AddRoute("/dashboard", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body>
+			<script>setTimeout(function() {
+				const el = document.createElement('div');
+				el.id = 'dashboard';
+				el.textContent = 'Welcome back';
+				document.body.appendChild(el);
+			}, 200);</script>
+		</body></html>`))
+	})
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.Navigate(testServer.URL + "/login")
+	s.Require().NoError(err)
+
+	el, err := page.WaitForElement("#dashboard", 5*time.Second, WaitForElementOptions{SurviveNavigation: true})
+	s.Require().NoError(err)
+
+	text, err := el.Text()
+	s.Require().NoError(err)
+	s.Equal("Welcome back", text)
+
+	s.Run("timeout when the element never appears", func() {
+		_, err := page.WaitForElement("#missing", 200*time.Millisecond, WaitForElementOptions{SurviveNavigation: true})
+		s.Require().Error(err)
+		s.Contains(err.Error(), "timeout")
+	})
+}
+
+func (s *WaitTestSuite) TestWaitForFunction() {
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	err = page.SetContent(`<html><body><script>
+		window.appReady = false;
+		setTimeout(function() { window.appReady = true; window.items = 3; }, 300);
+	</script></body></html>`)
+	s.Require().NoError(err)
+
+	s.Run("expression becomes truthy", func() {
+		result, err := page.WaitForFunction("window.appReady === true", 3*time.Second)
+		s.Require().NoError(err)
+		s.True(result.Bool())
+	})
+
+	s.Run("function with args returns the truthy value", func() {
+		result, err := page.WaitForFunction("(min) => window.items >= min && window.items", 3*time.Second, 2)
+		s.Require().NoError(err)
+		s.Equal(3, result.Int())
+	})
+
+	s.Run("timeout reports expression and last value", func() {
+		_, err := page.WaitForFunction("window.items > 10", 200*time.Millisecond)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "window.items > 10")
+		s.Contains(err.Error(), "last value: false")
+	})
+}
+
+func (s *WaitTestSuite) TestWaitForURL() {
+	testServer, cleanup := NewTestServer()
+	defer cleanup()
+
+	page, err := s.browser.NewPage()
+	s.Require().NoError(err)
+	defer page.Close()
+
+	s.Require().NoError(page.Navigate(testServer.URL))
+
+	// Change the URL the way an SPA router would, after a delay
+	_, err = page.Evaluate(`() => { setTimeout(() => history.pushState({}, '', '/admin/users/42'), 300) }`)
+	s.Require().NoError(err)
+
+	s.Run("glob", func() {
+		s.NoError(page.WaitForURL("**/admin/**", 3*time.Second))
+	})
+
+	s.Run("exact and regexp", func() {
+		s.NoError(page.WaitForURL(testServer.URL+"/admin/users/42", time.Second))
+		s.NoError(page.WaitForURL(`/users\/\d+$/`, time.Second))
+	})
+
+	s.Run("timeout reports the last URL", func() {
+		err := page.WaitForURL("**/login", 200*time.Millisecond)
+		s.Require().Error(err)
+		s.Contains(err.Error(), "/admin/users/42")
+	})
+
+	s.Run("invalid regexp", func() {
+		s.Error(page.WaitForURL("/([/", time.Second))
+	})
+}
+
+func TestURLMatcher(t *testing.T) {
+	tests := []struct {
+		pattern string
+		url     string
+		want    bool
+	}{
+		{"http://localhost/a?x=1", "http://localhost/a?x=1", true},
+		{"http://localhost/a", "http://localhost/a/b", false},
+		{"**/admin/**", "http://localhost:8080/admin/users", true},
+		{"**/admin/**", "http://localhost/login", false},
+		{`/\/item\/\d+$/`, "http://localhost/item/17", true},
+		{`/\/item\/\d+$/`, "http://localhost/item/new", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.url, func(t *testing.T) {
+			match, err := urlMatcher(tt.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, match(tt.url))
+		})
+	}
+
+	_, err := urlMatcher("/([/")
+	assert.Error(t, err)
+}
+
+func TestIsTruthy(t *testing.T) {
+	// Values as they arrive from CDP, JSON encoded
+	tests := []struct {
+		json string
+		want bool
+	}{
+		{`null`, false},
+		{`false`, false},
+		{`true`, true},
+		{`0`, false},
+		{`0.5`, true},
+		{`""`, false},
+		{`"ready"`, true},
+		{`[]`, true},
+		{`{}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			assert.Equal(t, tt.want, isTruthy(gson.NewFrom(tt.json)))
+		})
+	}
+}
+
+// Run the wait test suite
+func TestWaitSuite(t *testing.T) {
+	suite.Run(t, new(WaitTestSuite))
+}